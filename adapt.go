@@ -0,0 +1,210 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// adaptValue converts a native Go value into the shape produced by encoding/json,
+// which is what validators and the SchemaValidator work with:
+//   - pointers and interfaces are dereferenced, nil becomes nil
+//   - named string and bool types become string and bool
+//   - integers of any kind become int, floats become float64
+//   - json.Number becomes int or float64
+//   - slices and arrays become []any, nil slices become nil
+//   - maps with string keys become map[string]any, nil maps become nil
+//   - structs become map[string]any keyed by their json field names
+//
+// The conversion is shallow: elements of lists, maps and structs are adapted
+// when they are validated. Types that implement json.Marshaler, such as time.Time,
+// are returned unchanged.
+//
+//nolint:cyclop // One case per reflect.Kind
+func adaptValue(value any) any {
+	switch v := value.(type) {
+	case nil, string, bool, int, float64, map[string]any, []any, time.Time:
+		return value
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return value
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		return adaptValue(rv.Elem().Interface())
+	}
+
+	if _, ok := value.(json.Marshaler); ok {
+		return value
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if rv.Uint() > math.MaxInt {
+			return float64(rv.Uint())
+		}
+		return int(rv.Uint())
+	case reflect.Float32:
+		// Use the shortest decimal form so 1.23 stays 1.23 instead of 1.2300000190734863
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(rv.Float(), 'g', -1, 32), 64)
+		return f
+	case reflect.Float64:
+		return rv.Float()
+	case reflect.Slice, reflect.Array:
+		return adaptList(rv)
+	case reflect.Map:
+		return adaptMap(rv)
+	case reflect.Struct:
+		out := make(map[string]any, rv.NumField())
+		adaptStruct(rv, out)
+		return out
+	}
+
+	return value
+}
+
+// adaptList converts a slice or array into []any.
+func adaptList(rv reflect.Value) any {
+	if rv.Kind() == reflect.Slice && rv.IsNil() {
+		return nil
+	}
+	out := make([]any, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out
+}
+
+// adaptMap converts a map with string keys into map[string]any.
+// Maps with other key types are returned unchanged.
+func adaptMap(rv reflect.Value) any {
+	if rv.Type().Key().Kind() != reflect.String {
+		return rv.Interface()
+	}
+	if rv.IsNil() {
+		return nil
+	}
+	out := make(map[string]any, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		out[iter.Key().String()] = iter.Value().Interface()
+	}
+	return out
+}
+
+// adaptStruct stores the JSON fields of a struct in out, promoting fields of
+// embedded structs and skipping empty omitempty fields like encoding/json does.
+func adaptStruct(rv reflect.Value, out map[string]any) {
+	t := rv.Type()
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		name, omitEmpty, skip := jsonFieldName(sf)
+		if skip {
+			continue
+		}
+
+		field := rv.Field(i)
+		if sf.Anonymous && name == "" {
+			embedded := field
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				adaptStruct(embedded, out)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if omitEmpty && isEmptyValue(field) {
+			continue
+		}
+
+		out[name] = field.Interface()
+	}
+}
+
+// isEmptyValue reports whether encoding/json omits the value of an omitempty field.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return v.IsNil()
+	default:
+		return v.IsZero()
+	}
+}
+
+// nativeListLen returns the length of a non-nil slice or an array.
+func nativeListLen(value any) (int, bool) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() {
+			return 0, false
+		}
+		return rv.Len(), true
+	case reflect.Array:
+		return rv.Len(), true
+	}
+	return 0, false
+}
+
+// isNativeMap reports whether the value is a non-nil map with string keys or a struct.
+func isNativeMap(value any) bool {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Map:
+		return !rv.IsNil() && rv.Type().Key().Kind() == reflect.String
+	case reflect.Struct:
+		_, marshaler := value.(json.Marshaler)
+		return !marshaler
+	}
+	return false
+}
+
+// nativeNumber returns the value of any integer or float kind as float64.
+func nativeNumber(value any) (float64, bool) {
+	switch v := adaptValue(value).(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// isNativeInteger reports whether the value is of any integer kind.
+func isNativeInteger(value any) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,229 @@
+package govalidator_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type nativeRole string
+
+type nativeMeta struct {
+	Source string `json:"source"`
+}
+
+type nativeOrder struct {
+	nativeMeta
+	ID       uint64            `json:"id"`
+	Role     nativeRole        `json:"role"`
+	Qty      int8              `json:"qty"`
+	Price    float32           `json:"price"`
+	Tags     []string          `json:"tags"`
+	Sizes    [2]int            `json:"sizes"`
+	Counts   map[string]int    `json:"counts"`
+	Note     *string           `json:"note,omitempty"`
+	Comment  string            `json:"comment,omitempty"`
+	Created  time.Time         `json:"created"`
+	Skipped  string            `json:"-"`
+	NoTag    bool              //nolint:tagliatelle // Exercises the default field name
+	Lookup   map[int]string    `json:"lookup,omitempty"`
+	Children []nativeMeta      `json:"children"`
+	Labels   map[string]string `json:"labels"`
+	hidden   string
+}
+
+func nativeOrderSchema() *govalidator.Schema {
+	return govalidator.NewSchema().WithFields(
+		govalidator.NewField("source").Required().WithValidators(govalidator.IsStringValidator),
+		govalidator.NewField("id").Required().WithValidators(govalidator.IsIntegerValidator),
+		govalidator.NewField("role").Required().WithValidators(govalidator.IsStringValidator, govalidator.OneOfValidator("admin", "user")),
+		govalidator.NewField("qty").Required().WithValidators(govalidator.IsIntegerValidator, govalidator.MinFloatValidator(1)),
+		govalidator.NewField("price").Required().WithValidators(govalidator.NumberValidator, govalidator.FloatValidator(2)),
+		govalidator.NewField("tags").WithSchema(
+			govalidator.NewSchema(govalidator.IsListValidator, govalidator.MaxSizeValidator(2, false)).
+				WithItems(govalidator.NewSchema(govalidator.IsStringValidator)),
+		).Required(),
+		govalidator.NewField("sizes").WithSchema(govalidator.Array(govalidator.NewSchema(govalidator.IsIntegerValidator))),
+		govalidator.NewField("counts").WithSchema(govalidator.NewSchema(govalidator.IsMapValidator).Values(govalidator.NewSchema(govalidator.IsIntegerValidator))),
+		govalidator.NewField("note").Present(),
+		govalidator.NewField("comment"),
+		govalidator.NewField("created").Required(),
+		govalidator.NewField("NoTag").WithValidators(govalidator.IsBooleanValidator),
+		govalidator.NewField("children").WithSchema(govalidator.Array(govalidator.Object(map[string]*govalidator.Schema{
+			"source": govalidator.NewSchema(govalidator.MinLengthValidator(1)),
+		}))),
+		govalidator.NewField("labels").WithValidators(govalidator.IsMapValidator),
+	).WithExtra(govalidator.ExtraForbid)
+}
+
+func TestSchemaValidator_NativeValues(t *testing.T) {
+	note := "fragile"
+	order := nativeOrder{
+		nativeMeta: nativeMeta{Source: "web"},
+		ID:         7,
+		Role:       "admin",
+		Qty:        2,
+		Price:      1.23,
+		Tags:       []string{"a", "b"},
+		Sizes:      [2]int{1, 2},
+		Counts:     map[string]int{"x": 1},
+		Note:       &note,
+		Created:    time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Skipped:    "ignored",
+		Children:   []nativeMeta{{Source: "a"}},
+		Labels:     map[string]string{},
+		hidden:     "ignored",
+	}
+
+	t.Run("validates structs by json tag", func(t *testing.T) {
+		valid, errs := nativeOrderSchema().Validate(context.Background(), order)
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("validates pointers to structs", func(t *testing.T) {
+		valid, errs := nativeOrderSchema().Validate(context.Background(), &order)
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("reports errors at json paths", func(t *testing.T) {
+		bad := order
+		bad.Role = "root"
+		bad.Qty = 0
+		bad.Tags = []string{"a", "b", "c"}
+		bad.Note = nil
+		bad.Children = []nativeMeta{{}}
+
+		_, errs := nativeOrderSchema().Validate(context.Background(), bad)
+
+		assert.ElementsMatch(t, []string{"$.role", "$.qty", "$.tags", "$.note", "$.children[0].source"}, keys(errs))
+	})
+
+	t.Run("nil pointers and slices are null", func(t *testing.T) {
+		var missing *nativeOrder
+		valid, errs := nativeOrderSchema().Required().Validate(context.Background(), missing)
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"required"}}, errs)
+
+		bad := order
+		bad.Tags = nil
+		_, errs = nativeOrderSchema().Validate(context.Background(), bad)
+		assert.Equal(t, map[string][]string{"$.tags": {"required"}}, errs)
+	})
+
+	t.Run("normalizes native values into JSON shapes", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"tags": govalidator.Array(govalidator.NewSchema()),
+			"n":    govalidator.NewSchema(),
+		})
+
+		normalized, valid, _ := schema.Normalize(context.Background(), map[string]any{"tags": []string{"a"}, "n": int64(3)})
+
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"tags": []any{"a"}, "n": 3}, normalized)
+	})
+
+	t.Run("json numbers", func(t *testing.T) {
+		schema := govalidator.Array(govalidator.NewSchema(govalidator.NumberValidator, govalidator.MaxFloatValidator(10)))
+
+		_, errs := schema.Validate(context.Background(), []any{json.Number("3"), json.Number("1.5"), json.Number("11"), json.Number("x")})
+
+		assert.Equal(t, map[string][]string{"$[2]": {"value is greater than max"}, "$[3]": {"not a number"}}, errs)
+	})
+
+	t.Run("large unsigned integers", func(t *testing.T) {
+		schema := govalidator.NewSchema(govalidator.NumberValidator)
+
+		valid, _ := schema.Validate(context.Background(), uint64(math.MaxUint64))
+
+		assert.True(t, valid)
+	})
+
+	t.Run("maps with non-string keys are not objects", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{})
+
+		_, errs := schema.Validate(context.Background(), map[int]string{1: "a"})
+
+		assert.Equal(t, map[string][]string{"$": {"not a map"}}, errs)
+	})
+
+	t.Run("conditions look into native values", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("meta"),
+			govalidator.NewField("token").RequiredIf("meta.source", "api"),
+		)
+
+		_, errs := schema.Validate(context.Background(), map[string]any{"meta": nativeMeta{Source: "api"}})
+
+		assert.Equal(t, map[string][]string{"$.token": {"required when meta.source is api"}}, errs)
+	})
+
+	t.Run("rules compare native numbers", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithRules(govalidator.LessThanFieldRule("min", "max"))
+
+		_, errs := schema.Validate(context.Background(), map[string]any{"min": int32(5), "max": uint8(3)})
+
+		assert.Equal(t, map[string][]string{"$.min": {"must be less than max"}}, errs)
+	})
+}
+
+func TestTypeValidators_NativeValues(t *testing.T) {
+	ctx := context.Background()
+	type flag bool
+	type name string
+
+	tests := []struct {
+		name      string
+		validator govalidator.ContextValidator
+		value     any
+		wantErrs  bool
+	}{
+		{name: "integer int64", validator: govalidator.IsIntegerValidator, value: int64(1)},
+		{name: "integer uint8", validator: govalidator.IsIntegerValidator, value: uint8(1)},
+		{name: "integer rejects float32", validator: govalidator.IsIntegerValidator, value: float32(1.5), wantErrs: true},
+		{name: "number float32", validator: govalidator.NumberValidator, value: float32(1.5)},
+		{name: "number int16", validator: govalidator.NumberValidator, value: int16(1)},
+		{name: "number rejects string", validator: govalidator.NumberValidator, value: "1", wantErrs: true},
+		{name: "min float uint", validator: govalidator.MinFloatValidator(1), value: uint(2)},
+		{name: "min float too small", validator: govalidator.MinFloatValidator(1), value: int64(0), wantErrs: true},
+		{name: "max float float32", validator: govalidator.MaxFloatValidator(1), value: float32(0.5)},
+		{name: "max float rejects string", validator: govalidator.MaxFloatValidator(1), value: "0", wantErrs: true},
+		{name: "min size slice", validator: govalidator.MinSizeValidator(1, false), value: []string{"a"}},
+		{name: "min size array too small", validator: govalidator.MinSizeValidator(3, false), value: [2]int{}, wantErrs: true},
+		{name: "min size rejects map", validator: govalidator.MinSizeValidator(1, false), value: map[string]int{"a": 1}, wantErrs: true},
+		{name: "max size slice too large", validator: govalidator.MaxSizeValidator(1, false), value: []int{1, 2}, wantErrs: true},
+		{name: "max size array", validator: govalidator.MaxSizeValidator(2, false), value: [2]int{}},
+		{name: "max size rejects string", validator: govalidator.MaxSizeValidator(2, false), value: "ab", wantErrs: true},
+		{name: "list slice", validator: govalidator.IsListValidator, value: []int{}},
+		{name: "list array", validator: govalidator.IsListValidator, value: [1]string{}},
+		{name: "list rejects nil slice", validator: govalidator.IsListValidator, value: []string(nil), wantErrs: true},
+		{name: "map of ints", validator: govalidator.IsMapValidator, value: map[string]int{}},
+		{name: "map struct", validator: govalidator.IsMapValidator, value: nativeMeta{}},
+		{name: "map rejects int keys", validator: govalidator.IsMapValidator, value: map[int]int{}, wantErrs: true},
+		{name: "map rejects nil map", validator: govalidator.IsMapValidator, value: map[string]int(nil), wantErrs: true},
+		{name: "map rejects time", validator: govalidator.IsMapValidator, value: time.Time{}, wantErrs: true},
+		{name: "string named type", validator: govalidator.IsStringValidator, value: name("a")},
+		{name: "boolean named type", validator: govalidator.IsBooleanValidator, value: flag(true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := tt.validator(ctx, tt.value)
+
+			if tt.wantErrs {
+				assert.NotEmpty(t, errs)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
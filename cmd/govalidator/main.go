@@ -0,0 +1,426 @@
+// Command govalidator validates JSON, YAML and newline-delimited JSON files
+// against a schema file.
+//
+// Usage:
+//
+//	govalidator -schema schema.yaml [flags] [file ...]
+//
+// The schema file is either a JSON Schema document or a schema file in the
+// format read by govalidator.SchemaFromYAML, written in JSON or YAML. Data is
+// read from the given files, or from standard input if there are none or a file
+// is "-". Each JSON value, YAML document or NDJSON line is validated separately;
+// errors in JSON and NDJSON input start with their line and column.
+//
+// The exit status is 0 if every value is valid, 1 if any value is invalid or
+// cannot be parsed or any data file cannot be read, and 2 for usage errors and
+// unreadable schemas. Unreadable data files are reported like invalid values,
+// and the remaining files are still validated.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gstachniukrsk/govalidator"
+	"gopkg.in/yaml.v3"
+)
+
+// Exit statuses.
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+// Data formats.
+const (
+	formatAuto   = "auto"
+	formatJSON   = "json"
+	formatYAML   = "yaml"
+	formatNDJSON = "ndjson"
+)
+
+// Schema formats.
+const (
+	schemaFormatJSONSchema = "jsonschema"
+	schemaFormatFile       = "schema"
+)
+
+// stdinName names standard input in arguments and output.
+const stdinName = "-"
+
+// maxLineSize bounds the length of a single NDJSON line.
+const maxLineSize = 64 * 1024 * 1024
+
+// formatsByExtension maps file extensions to data formats.
+var formatsByExtension = map[string]string{
+	".json":   formatJSON,
+	".yaml":   formatYAML,
+	".yml":    formatYAML,
+	".ndjson": formatNDJSON,
+	".jsonl":  formatNDJSON,
+}
+
+// presenters builds the combiner of each -presenter value.
+var presenters = map[string]func() govalidator.PresenterFunc{
+	"combined": func() govalidator.PresenterFunc {
+		return govalidator.CombinedPresenter(".", ": ")
+	},
+	"json": func() govalidator.PresenterFunc {
+		return govalidator.JSONPresenter(".")
+	},
+	"detailed": func() govalidator.PresenterFunc {
+		pathPresenter := govalidator.PathPresenter(".")
+		errorPresenter := govalidator.DetailedErrorPresenter()
+		return func(ctx context.Context, path []string, err error) string {
+			return pathPresenter(ctx, path, err) + ": " + errorPresenter(ctx, path, err)
+		}
+	},
+}
+
+// record is a single value to validate, named by its source for output.
+// Records read from JSON keep their text so that errors can be located in the file.
+type record struct {
+	source string
+	value  any
+	err    error
+
+	// file names the file the JSON text starts in, at line
+	file string
+	line int
+	data []byte
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns its exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("govalidator", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	schemaPath := flags.String("schema", "", "schema file (JSON Schema or govalidator schema file, in JSON or YAML)")
+	schemaFormat := flags.String("schema-format", formatAuto, "schema format: auto, jsonschema or schema")
+	format := flags.String("format", formatAuto, "data format: auto, json, yaml or ndjson; auto uses the file extension and json for stdin")
+	presenterName := flags.String("presenter", "combined", "error output: combined, json or detailed")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: govalidator -schema FILE [flags] [FILE ...]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	newPresenter, ok := presenters[*presenterName]
+	if !ok {
+		fmt.Fprintf(stderr, "govalidator: unknown presenter %q\n", *presenterName)
+		return exitUsage
+	}
+	if *format != formatAuto && !isDataFormat(*format) {
+		fmt.Fprintf(stderr, "govalidator: unknown format %q\n", *format)
+		return exitUsage
+	}
+	if *schemaPath == "" {
+		fmt.Fprintln(stderr, "govalidator: -schema is required")
+		flags.Usage()
+		return exitUsage
+	}
+
+	schema, err := loadSchema(*schemaPath, *schemaFormat)
+	if err != nil {
+		fmt.Fprintf(stderr, "govalidator: %v\n", err)
+		return exitUsage
+	}
+
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{stdinName}
+	}
+
+	v := &runner{
+		schema:    schema,
+		validator: govalidator.NewSchemaValidator(govalidator.PathPresenter("."), govalidator.SimpleErrorPresenter()),
+		presenter: newPresenter(),
+		json:      *presenterName == "json",
+		stdout:    stdout,
+		status:    exitValid,
+	}
+	for _, file := range files {
+		readRecords(file, *format, stdin, v.validate)
+	}
+	return v.status
+}
+
+// runner validates records and reports their errors.
+type runner struct {
+	schema    *govalidator.Schema
+	validator *govalidator.SchemaValidator
+	presenter govalidator.PresenterFunc
+	json      bool
+	stdout    io.Writer
+	status    int
+}
+
+// validate validates one record and prints its errors.
+func (v *runner) validate(r record) {
+	var errs []string
+	if r.err != nil {
+		errs = []string{r.err.Error()}
+		if v.json {
+			message, _ := json.Marshal(map[string]string{"path": "", "message": r.err.Error()})
+			errs = []string{string(message)}
+		}
+	} else {
+		var valid bool
+		if r.data != nil {
+			valid, errs = v.validator.ValidateJSON(context.Background(), r.data, v.schema, v.locate(r))
+		} else {
+			valid, errs = v.validator.ValidateFlat(context.Background(), r.value, v.schema, v.presenter)
+		}
+		if valid {
+			return
+		}
+	}
+	v.status = exitInvalid
+
+	if v.json {
+		presented := make([]json.RawMessage, len(errs))
+		for i, e := range errs {
+			presented[i] = json.RawMessage(e)
+		}
+		encoder := json.NewEncoder(v.stdout)
+		encoder.SetEscapeHTML(false)
+		_ = encoder.Encode(struct {
+			Source string            `json:"source"`
+			Errors []json.RawMessage `json:"errors"`
+		}{Source: r.source, Errors: presented})
+		return
+	}
+	for _, e := range errs {
+		if r.data != nil {
+			// Located errors start with their position in the file
+			fmt.Fprintln(v.stdout, e)
+			continue
+		}
+		fmt.Fprintf(v.stdout, "%s: %s\n", r.source, e)
+	}
+}
+
+// locate creates a combiner presenting the errors of a JSON record with their
+// position in its file: as a "file:line:column" prefix, or as fields of JSON output.
+func (v *runner) locate(r record) govalidator.PresenterFunc {
+	return func(ctx context.Context, path []string, err error) string {
+		var located govalidator.LocatedError
+		if !errors.As(err, &located) {
+			return r.source + ": " + v.presenter(ctx, path, err)
+		}
+		located.Position.Line += r.line - 1
+
+		presented := v.presenter(ctx, path, located)
+		if v.json {
+			return presented
+		}
+		return fmt.Sprintf("%s:%s: %s", r.file, located.Position, presented)
+	}
+}
+
+// loadSchema reads a schema file in the given format, or guesses it for "auto".
+func loadSchema(path, format string) (*govalidator.Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// YAML is a superset of JSON, so both are read the same way
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	doc, err = jsonShape(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	data, err = json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if format == formatAuto {
+		format = schemaFormatFile
+		if govalidator.IsJSONSchema(data) {
+			format = schemaFormatJSONSchema
+		}
+	}
+
+	var schema *govalidator.Schema
+	switch format {
+	case schemaFormatJSONSchema:
+		schema, err = govalidator.FromJSONSchema(data)
+	case schemaFormatFile:
+		schema, err = govalidator.SchemaFromJSON(data, nil)
+	default:
+		return nil, fmt.Errorf("unknown schema format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return schema, nil
+}
+
+// readRecords reads the values of a data file, or of stdin for "-", passing
+// each to emit as soon as it is read. Files that cannot be opened and values
+// that cannot be parsed become records carrying the error.
+func readRecords(file, format string, stdin io.Reader, emit func(record)) {
+	name := file
+	var reader io.Reader = stdin
+	if file == stdinName {
+		name = "<stdin>"
+	} else {
+		f, err := os.Open(file)
+		if err != nil {
+			// The record is named by the file already
+			var pathErr *fs.PathError
+			if errors.As(err, &pathErr) {
+				err = pathErr.Err
+			}
+			emit(record{source: name, err: err})
+			return
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	if format == formatAuto {
+		format = formatJSON
+		if byExtension, ok := formatsByExtension[strings.ToLower(filepath.Ext(file))]; ok {
+			format = byExtension
+		}
+	}
+
+	switch format {
+	case formatYAML:
+		readYAML(name, reader, emit)
+	case formatNDJSON:
+		readNDJSON(name, reader, emit)
+	default:
+		readJSON(name, reader, emit)
+	}
+}
+
+// readJSON reads a single JSON value. The whole text is kept to locate errors in it.
+func readJSON(name string, reader io.Reader, emit func(record)) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		emit(record{source: name, err: err})
+		return
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		emit(record{source: name, err: fmt.Errorf("invalid JSON: %w", err)})
+		return
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		emit(record{source: name, err: errors.New("invalid JSON: unexpected data after the top-level value")})
+		return
+	}
+	emit(record{source: name, value: value, file: name, line: 1, data: data})
+}
+
+// readYAML reads every document of a YAML stream. When the stream has more than
+// one document, every document is named by its number.
+func readYAML(name string, reader io.Reader, emit func(record)) {
+	decoder := yaml.NewDecoder(reader)
+
+	// The first document is held back until it is known whether another one follows
+	var first *record
+	for n := 1; ; n++ {
+		var value any
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		r := record{source: fmt.Sprintf("%s#%d", name, n)}
+		invalid := err != nil
+		if invalid {
+			r.err = fmt.Errorf("invalid YAML: %w", err)
+		} else if r.value, err = jsonShape(value); err != nil {
+			r.err = fmt.Errorf("unsupported YAML value: %w", err)
+		}
+
+		if n == 1 {
+			first = &r
+		} else {
+			if first != nil {
+				emit(*first)
+				first = nil
+			}
+			emit(r)
+		}
+		if invalid {
+			// The decoder cannot recover from a syntax error
+			break
+		}
+	}
+	if first != nil {
+		first.source = name
+		emit(*first)
+	}
+}
+
+// readNDJSON reads one JSON value per line, skipping blank lines, and emits
+// each before reading the next. Records are named by their line number.
+func readNDJSON(name string, reader io.Reader, emit func(record)) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		source := fmt.Sprintf("%s:%d", name, line)
+		var value any
+		if err := json.Unmarshal(text, &value); err != nil {
+			emit(record{source: source, err: fmt.Errorf("invalid JSON: %w", err)})
+			continue
+		}
+		// The scanner reuses its buffer, and the record is validated before the next line is read
+		emit(record{source: source, value: value, file: name, line: line, data: scanner.Bytes()})
+	}
+	if err := scanner.Err(); err != nil {
+		emit(record{source: fmt.Sprintf("%s:%d", name, line+1), err: err})
+	}
+}
+
+// jsonShape converts a decoded YAML value to what encoding/json would decode
+// from its JSON form: float64 numbers, string keys and RFC 3339 timestamps.
+// Values without a JSON form, such as mappings with non-string keys, return an error.
+func jsonShape(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var shaped any
+	if err := json.Unmarshal(data, &shaped); err != nil {
+		return nil, err
+	}
+	return shaped, nil
+}
+
+// isDataFormat reports whether format names a data format.
+func isDataFormat(format string) bool {
+	return format == formatJSON || format == formatYAML || format == formatNDJSON
+}
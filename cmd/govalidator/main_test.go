@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userSchemaFile = `
+type: object
+required: true
+fields:
+  name:
+    type: string
+    required: true
+    validators:
+      - min_length: 3
+  age:
+    type: integer
+`
+
+const userJSONSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"type": "object",
+	"properties": {"name": {"type": "string", "minLength": 3}, "age": {"type": "integer"}},
+	"required": ["name"]
+}`
+
+// writeFiles writes the named files to a temporary directory and returns its path.
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+// runCommand runs the command with stdin and returns its exit status and output.
+func runCommand(dir string, stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	for i, arg := range args {
+		if strings.HasPrefix(arg, "@") {
+			args[i] = filepath.Join(dir, arg[1:])
+		}
+	}
+	status := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return status, stdout.String(), stderr.String()
+}
+
+func TestRun(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"user.schema.yaml": userSchemaFile,
+		"user.schema.json": userJSONSchema,
+		"valid.json":       `{"name": "Ann", "age": 30}`,
+		"invalid.json":     `{"name": "An", "age": 1.5}`,
+		"users.yaml":       "name: Ann\n---\nname: Bo\n",
+		"users.ndjson":     "{\"name\": \"Ann\"}\n\n{\"name\": 1}\nnot json\n",
+		"broken.json":      `{"name": "Ann"} {}`,
+		"multiline.json":   "{\n  \"name\": \"Ann\",\n  \"age\": 1.5\n}\n",
+		"multiline.ndjson": "{\"name\": \"Ann\"}\n\n\n  {\"name\": \"Al\"}\n",
+	})
+
+	t.Run("valid files", func(t *testing.T) {
+		for _, schema := range []string{"@user.schema.yaml", "@user.schema.json"} {
+			status, stdout, stderr := runCommand(dir, "", "-schema", schema, "@valid.json")
+
+			assert.Equal(t, exitValid, status)
+			assert.Empty(t, stdout)
+			assert.Empty(t, stderr)
+		}
+	})
+
+	t.Run("invalid files", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, "", "-schema", "@user.schema.yaml", "@valid.json", "@invalid.json")
+
+		assert.Equal(t, exitInvalid, status)
+		file := filepath.Join(dir, "invalid.json")
+		assert.ElementsMatch(t, []string{
+			file + ":1:10: $.name: expected at least 3 characters",
+			file + ":1:23: $.age: not an integer",
+		}, lines(stdout))
+	})
+
+	t.Run("JSON Schema", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, "", "-schema", "@user.schema.json", "@invalid.json")
+
+		assert.Equal(t, exitInvalid, status)
+		assert.Len(t, lines(stdout), 2)
+	})
+
+	t.Run("YAML documents", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, "", "-schema", "@user.schema.yaml", "@users.yaml")
+
+		assert.Equal(t, exitInvalid, status)
+		assert.Equal(t, []string{filepath.Join(dir, "users.yaml") + "#2: $.name: expected at least 3 characters"}, lines(stdout))
+	})
+
+	t.Run("NDJSON lines", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, "", "-schema", "@user.schema.yaml", "@users.ndjson")
+
+		assert.Equal(t, exitInvalid, status)
+		file := filepath.Join(dir, "users.ndjson")
+		out := lines(stdout)
+		require.Len(t, out, 2)
+		assert.Equal(t, file+":3:10: $.name: not a string", out[0])
+		assert.True(t, strings.HasPrefix(out[1], file+":4: invalid JSON: "))
+	})
+
+	t.Run("YAML values without a JSON form", func(t *testing.T) {
+		dir := writeFiles(t, map[string]string{
+			"user.schema.yaml":      userSchemaFile,
+			"bool-keys.yaml":        "name: Ann\n---\ntrue: yes\n---\nname: Bo\n",
+			"bool-keys.schema.yaml": "type: object\nfields:\n  true: {type: string}\n",
+			"valid.json":            `{"name": "Ann"}`,
+		})
+
+		status, stdout, _ := runCommand(dir, "", "-schema", "@user.schema.yaml", "@bool-keys.yaml")
+
+		assert.Equal(t, exitInvalid, status)
+		file := filepath.Join(dir, "bool-keys.yaml")
+		out := lines(stdout)
+		require.Len(t, out, 2)
+		assert.True(t, strings.HasPrefix(out[0], file+"#2: unsupported YAML value: "))
+		assert.Equal(t, file+"#3: $.name: expected at least 3 characters", out[1])
+
+		status, _, stderr := runCommand(dir, "", "-schema", "@bool-keys.schema.yaml", "@valid.json")
+		assert.Equal(t, exitUsage, status)
+		assert.True(t, strings.HasPrefix(stderr, "govalidator: "+filepath.Join(dir, "bool-keys.schema.yaml")+": json: "))
+	})
+
+	t.Run("NDJSON lines are validated as they are read", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		stdin := &lineReader{lines: []string{`{"name": "Al"}` + "\n", `{"name": "Bo"}` + "\n"}}
+		stdin.before = func(i int) {
+			if i == 1 {
+				// The first line was reported before the second is read
+				assert.Equal(t, []string{"<stdin>:1:10: $.name: expected at least 3 characters"}, lines(stdout.String()))
+			}
+		}
+
+		status := run([]string{"-schema", filepath.Join(dir, "user.schema.yaml"), "-format", "ndjson"}, stdin, &stdout, &stderr)
+
+		assert.Equal(t, exitInvalid, status)
+		assert.Len(t, lines(stdout.String()), 2)
+	})
+
+	t.Run("stdin", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, `{"name": "Al"}`, "-schema", "@user.schema.yaml")
+		assert.Equal(t, exitInvalid, status)
+		assert.Equal(t, []string{"<stdin>:1:10: $.name: expected at least 3 characters"}, lines(stdout))
+
+		status, _, _ = runCommand(dir, "name: Ann\n", "-schema", "@user.schema.yaml", "-format", "yaml", "-")
+		assert.Equal(t, exitValid, status)
+	})
+
+	t.Run("source positions", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, "", "-schema", "@user.schema.yaml", "@multiline.json", "@multiline.ndjson")
+
+		assert.Equal(t, exitInvalid, status)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "multiline.json") + ":3:10: $.age: not an integer",
+			filepath.Join(dir, "multiline.ndjson") + ":4:12: $.name: expected at least 3 characters",
+		}, lines(stdout))
+	})
+
+	t.Run("trailing data", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, "", "-schema", "@user.schema.yaml", "@broken.json")
+
+		assert.Equal(t, exitInvalid, status)
+		assert.Equal(t, []string{filepath.Join(dir, "broken.json") + ": invalid JSON: unexpected data after the top-level value"}, lines(stdout))
+	})
+
+	t.Run("unreadable files", func(t *testing.T) {
+		status, stdout, stderr := runCommand(dir, "", "-schema", "@user.schema.yaml", "@nope.json", "@broken.json", "@invalid.json")
+
+		assert.Equal(t, exitInvalid, status)
+		assert.Empty(t, stderr)
+		out := lines(stdout)
+		require.Len(t, out, 4)
+		assert.Equal(t, filepath.Join(dir, "nope.json")+": no such file or directory", out[0])
+		assert.Equal(t, filepath.Join(dir, "broken.json")+": invalid JSON: unexpected data after the top-level value", out[1])
+		assert.ElementsMatch(t, []string{
+			filepath.Join(dir, "invalid.json") + ":1:10: $.name: expected at least 3 characters",
+			filepath.Join(dir, "invalid.json") + ":1:23: $.age: not an integer",
+		}, out[2:])
+	})
+
+	t.Run("JSON presenter", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, `{"name": "Al"}`+"\nx\n", "-schema", "@user.schema.yaml", "-presenter", "json", "-format", "ndjson")
+
+		assert.Equal(t, exitInvalid, status)
+		out := lines(stdout)
+		require.Len(t, out, 2)
+		assert.JSONEq(t, `{"source": "<stdin>:1", "errors": [{"path": "$.name", "message": "expected at least 3 characters", "line": 1, "column": 10, "offset": 9}]}`, out[0])
+		assert.Contains(t, out[1], `"source":"<stdin>:2"`)
+	})
+
+	t.Run("detailed presenter", func(t *testing.T) {
+		status, stdout, _ := runCommand(dir, `{"age": 1}`, "-schema", "@user.schema.yaml", "-presenter", "detailed")
+
+		assert.Equal(t, exitInvalid, status)
+		assert.Equal(t, []string{"<stdin>:1:1: $.name: this field is required"}, lines(stdout))
+	})
+}
+
+func TestRun_UsageErrors(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"user.schema.yaml": userSchemaFile,
+		"bad.schema.yaml":  "type: decimal\n",
+		"broken.yaml":      "a: [b\n",
+	})
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "missing schema", args: nil, want: "govalidator: -schema is required"},
+		{name: "unknown flag", args: []string{"-nope"}, want: "flag provided but not defined: -nope"},
+		{name: "unknown presenter", args: []string{"-schema", "@user.schema.yaml", "-presenter", "xml"}, want: `unknown presenter "xml"`},
+		{name: "unknown format", args: []string{"-schema", "@user.schema.yaml", "-format", "csv"}, want: `unknown format "csv"`},
+		{name: "unknown schema format", args: []string{"-schema", "@user.schema.yaml", "-schema-format", "xsd"}, want: `unknown schema format "xsd"`},
+		{name: "missing schema file", args: []string{"-schema", "@nope.yaml"}, want: "no such file or directory"},
+		{name: "invalid schema", args: []string{"-schema", "@bad.schema.yaml"}, want: "invalid schema file at $.type: unknown type decimal"},
+		{name: "unparsable schema", args: []string{"-schema", "@broken.yaml"}, want: "broken.yaml: yaml:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, stdout, stderr := runCommand(dir, "", tt.args...)
+
+			assert.Equal(t, exitUsage, status)
+			assert.Empty(t, stdout)
+			assert.Contains(t, stderr, tt.want)
+		})
+	}
+}
+
+// lines splits output into lines, without the trailing newline.
+// lineReader returns one line per Read, calling before with the index of the line about to be returned.
+type lineReader struct {
+	lines  []string
+	next   int
+	before func(i int)
+}
+
+// Read returns the next line.
+func (r *lineReader) Read(p []byte) (int, error) {
+	if r.next == len(r.lines) {
+		return 0, io.EOF
+	}
+	r.before(r.next)
+	n := copy(p, r.lines[r.next])
+	r.next++
+	return n, nil
+}
+
+func lines(output string) []string {
+	if output == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+}
@@ -0,0 +1,235 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PathError is a validation error together with the path at which it was found.
+type PathError struct {
+	Path []string
+	Err  error
+}
+
+// BranchErrors holds the errors reported by a single branch of a schema combinator.
+type BranchErrors struct {
+	Index  int
+	Errors []PathError
+}
+
+// AnyOfError is returned when a value does not match any branch of an AnyOf schema.
+type AnyOfError struct {
+	Branches []BranchErrors
+}
+
+// ExactlyOneOfError is returned when a value does not match exactly one branch of an ExactlyOneOf schema.
+// Matched lists the indexes of matching branches; Branches holds the errors of the rejected branches
+// when nothing matched.
+type ExactlyOneOfError struct {
+	Matched  []int
+	Branches []BranchErrors
+}
+
+// AllOfError is returned when a value does not match every branch of an AllOf schema.
+// Branches holds the errors of the rejected branches only.
+type AllOfError struct {
+	Branches []BranchErrors
+}
+
+// recordingCollector keeps raw errors so they can be attached to a combinator error.
+type recordingCollector struct {
+	errors []PathError
+}
+
+// Error returns the error message.
+func (e PathError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Err.Error()
+	}
+	return PathPresenter(".")(context.Background(), e.Path, e.Err) + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e PathError) Unwrap() error {
+	return e.Err
+}
+
+// Error returns the error message.
+func (e AnyOfError) Error() string {
+	return fmt.Sprintf("value does not match any of %d schemas%s", len(e.Branches), describeBranches(e.Branches))
+}
+
+// Error returns the error message.
+func (e ExactlyOneOfError) Error() string {
+	if len(e.Matched) == 0 {
+		return fmt.Sprintf("value does not match any of %d schemas%s", len(e.Branches), describeBranches(e.Branches))
+	}
+	return fmt.Sprintf("value matches %d schemas %v, expected exactly one", len(e.Matched), e.Matched)
+}
+
+// Error returns the error message.
+func (e AllOfError) Error() string {
+	return fmt.Sprintf("value does not match %d schema(s)%s", len(e.Branches), describeBranches(e.Branches))
+}
+
+// describeBranches renders branch errors as "; [0] $.a: required; [1] $: not a string".
+func describeBranches(branches []BranchErrors) string {
+	var sb strings.Builder
+	for _, branch := range branches {
+		for _, err := range branch.Errors {
+			fmt.Fprintf(&sb, "; [%d] %s", branch.Index, err.Error())
+		}
+	}
+	return sb.String()
+}
+
+// AnyOf creates a schema that matches when the value matches at least one of the branches.
+// Normalize keeps the defaults and conversions of the branches that match.
+//
+// Example:
+//
+//	schema := AnyOf(
+//	    NewSchema(IsStringValidator),
+//	    NewSchema(IsIntegerValidator),
+//	).Required()
+func AnyOf(branches ...*Schema) *Schema {
+	return &Schema{
+		Extra: ExtraIgnore,
+		anyOf: branches,
+	}
+}
+
+// ExactlyOneOf creates a schema that matches when the value matches exactly one of the branches.
+// Normalize keeps the defaults and conversions of the matching branch.
+//
+// Example:
+//
+//	schema := ExactlyOneOf(
+//	    Object(map[string]*Schema{"iban": NewSchema(IsStringValidator).Required()}),
+//	    Object(map[string]*Schema{"card": NewSchema(IsStringValidator).Required()}),
+//	)
+func ExactlyOneOf(branches ...*Schema) *Schema {
+	return &Schema{
+		Extra:        ExtraIgnore,
+		exactlyOneOf: branches,
+	}
+}
+
+// AllOf creates a schema that matches when the value matches every branch.
+// Normalize keeps the defaults and conversions of every branch.
+//
+// Example:
+//
+//	schema := AllOf(
+//	    NewSchema(IsStringValidator),
+//	    NewSchema(MinLengthValidator(3)),
+//	)
+func AllOf(branches ...*Schema) *Schema {
+	return &Schema{
+		Extra: ExtraIgnore,
+		allOf: branches,
+	}
+}
+
+// Collect records an error at the given path.
+func (c *recordingCollector) Collect(path []string, err error) {
+	c.errors = append(c.errors, PathError{Path: path, Err: err})
+}
+
+// GetErrors returns recorded errors grouped by path.
+func (c *recordingCollector) GetErrors() map[string][]string {
+	errs := make(map[string][]string, len(c.errors))
+	for _, e := range c.errors {
+		key := PathPresenter(".")(context.Background(), e.Path, e.Err)
+		errs[key] = append(errs[key], e.Err.Error())
+	}
+	return errs
+}
+
+// HasErrors returns true if any errors were recorded.
+func (c *recordingCollector) HasErrors() bool {
+	return len(c.errors) > 0
+}
+
+// validateCombinators applies the AnyOf, ExactlyOneOf and AllOf branches of the schema.
+// When normalizing, returns the values normalized by the branches that matched.
+func (sv *SchemaValidator) validateCombinators(valCtx *ValidationContext, value any, schema *Schema) []any {
+	var normalized []any
+
+	if len(schema.anyOf) > 0 {
+		failed, matched := sv.validateBranches(valCtx, value, schema.anyOf)
+		if len(failed) == len(schema.anyOf) {
+			valCtx.errorCollector.Collect(valCtx.path, AnyOfError{Branches: failed})
+		}
+		normalized = append(normalized, matched...)
+	}
+
+	if len(schema.exactlyOneOf) > 0 {
+		failed, matched := sv.validateBranches(valCtx, value, schema.exactlyOneOf)
+		if len(schema.exactlyOneOf)-len(failed) != 1 {
+			valCtx.errorCollector.Collect(valCtx.path, newExactlyOneOfError(len(schema.exactlyOneOf), failed))
+		}
+		normalized = append(normalized, matched...)
+	}
+
+	if len(schema.allOf) > 0 {
+		failed, matched := sv.validateBranches(valCtx, value, schema.allOf)
+		if len(failed) > 0 {
+			valCtx.errorCollector.Collect(valCtx.path, AllOfError{Branches: failed})
+		}
+		normalized = append(normalized, matched...)
+	}
+
+	return normalized
+}
+
+// validateBranches validates the value against each branch with an isolated collector
+// and returns the errors of the branches that rejected it and, when normalizing,
+// the values normalized by the branches that matched.
+func (sv *SchemaValidator) validateBranches(
+	valCtx *ValidationContext,
+	value any,
+	branches []*Schema,
+) (failed []BranchErrors, matched []any) {
+	for i, branch := range branches {
+		errs, normalized := sv.validateSilently(valCtx, value, branch)
+		if len(errs) > 0 {
+			failed = append(failed, BranchErrors{Index: i, Errors: errs})
+		} else if valCtx.normalize {
+			matched = append(matched, normalized)
+		}
+	}
+	return failed, matched
+}
+
+// validateSilently validates the value against the schema without reporting errors
+// to the caller's collector and returns what would have been reported, with the
+// normalized value when normalizing.
+func (sv *SchemaValidator) validateSilently(valCtx *ValidationContext, value any, schema *Schema) ([]PathError, any) {
+	collector := &recordingCollector{}
+	isolated := *valCtx
+	isolated.errorCollector = collector
+	normalized := sv.validateValue(&isolated, value, schema)
+	return collector.errors, normalized
+}
+
+// newExactlyOneOfError builds the error for an ExactlyOneOf schema with the given rejected branches.
+func newExactlyOneOfError(total int, failed []BranchErrors) ExactlyOneOfError {
+	if len(failed) == total {
+		return ExactlyOneOfError{Branches: failed}
+	}
+
+	rejected := make(map[int]bool, len(failed))
+	for _, branch := range failed {
+		rejected[branch.Index] = true
+	}
+
+	matched := make([]int, 0, total-len(failed))
+	for i := range total {
+		if !rejected[i] {
+			matched = append(matched, i)
+		}
+	}
+	return ExactlyOneOfError{Matched: matched}
+}
@@ -0,0 +1,204 @@
+package govalidator_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnyOf(t *testing.T) {
+	schema := govalidator.AnyOf(
+		govalidator.NewSchema(govalidator.IsStringValidator),
+		govalidator.NewSchema(govalidator.IsIntegerValidator),
+	).Required()
+
+	t.Run("accepts value matching any branch", func(t *testing.T) {
+		for _, value := range []any{"text", 42} {
+			valid, errs := schema.Validate(context.Background(), value)
+
+			assert.True(t, valid)
+			assert.Empty(t, errs)
+		}
+	})
+
+	t.Run("rejects value matching no branch", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), true)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$": {"value does not match any of 2 schemas; [0] $: not a string; [1] $: not an integer"},
+		}, errs)
+	})
+
+	t.Run("required is checked before branches", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), nil)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"required"}}, errs)
+	})
+
+	t.Run("branch errors keep nested paths", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"payment": govalidator.AnyOf(
+				govalidator.Object(map[string]*govalidator.Schema{
+					"iban": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+				}),
+				govalidator.Object(map[string]*govalidator.Schema{
+					"card": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+				}),
+			),
+		})
+
+		var got error
+		collector := func(_ context.Context, _ []string, err error) string {
+			got = err
+			return err.Error()
+		}
+		valid, _ := schema.ValidateWithPresenter(
+			context.Background(),
+			map[string]any{"payment": map[string]any{}},
+			govalidator.PathPresenter("."),
+			collector,
+		)
+
+		assert.False(t, valid)
+		var anyOfErr govalidator.AnyOfError
+		require.ErrorAs(t, got, &anyOfErr)
+		require.Len(t, anyOfErr.Branches, 2)
+		assert.Equal(t, []string{"$", "payment", "iban"}, anyOfErr.Branches[0].Errors[0].Path)
+		assert.Equal(t, []string{"$", "payment", "card"}, anyOfErr.Branches[1].Errors[0].Path)
+	})
+}
+
+func TestExactlyOneOf(t *testing.T) {
+	schema := govalidator.ExactlyOneOf(
+		govalidator.NewSchema(govalidator.IsStringValidator),
+		govalidator.NewSchema(govalidator.IsStringValidator, govalidator.MinLengthValidator(5)),
+		govalidator.NewSchema(govalidator.IsIntegerValidator),
+	)
+
+	t.Run("accepts value matching exactly one branch", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), "abc")
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("rejects value matching several branches", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), "abcdef")
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$": {"value matches 2 schemas [0 1], expected exactly one"},
+		}, errs)
+	})
+
+	t.Run("rejects value matching no branch", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), false)
+
+		assert.False(t, valid)
+		assert.Len(t, errs["$"], 1)
+		assert.Contains(t, errs["$"][0], "value does not match any of 3 schemas")
+	})
+}
+
+func TestAllOf(t *testing.T) {
+	schema := govalidator.AllOf(
+		govalidator.NewSchema(govalidator.IsStringValidator),
+		govalidator.NewSchema(govalidator.MinLengthValidator(3)),
+		govalidator.NewSchema(govalidator.LowerCaseValidator),
+	)
+
+	t.Run("accepts value matching all branches", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), "abc")
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("reports rejected branches only", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), "AB")
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$": {`value does not match 2 schema(s); [1] $: expected at least 3 characters; [2] $: "AB" is not lower cased`},
+		}, errs)
+	})
+
+	t.Run("combines with fields of the same schema", func(t *testing.T) {
+		schema := govalidator.AllOf(
+			govalidator.Object(map[string]*govalidator.Schema{
+				"a": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+			}),
+		).WithFields(map[string]*govalidator.Schema{
+			"b": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"a": "x"})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.b": {"required"}}, errs)
+	})
+}
+
+func TestCombinatorErrors_Presenters(t *testing.T) {
+	branches := []govalidator.BranchErrors{
+		{Index: 0, Errors: []govalidator.PathError{{Path: []string{"$", "a"}, Err: govalidator.RequiredError{}}}},
+		{Index: 1, Errors: []govalidator.PathError{{Path: []string{"$"}, Err: govalidator.NotAStringError{}}}},
+	}
+
+	t.Run("path error", func(t *testing.T) {
+		err := govalidator.PathError{Path: []string{"$", "items", "[0]"}, Err: govalidator.RequiredError{}}
+
+		assert.EqualError(t, err, "$.items[0]: required")
+		assert.ErrorIs(t, err, govalidator.RequiredError{})
+	})
+
+	t.Run("JSONDetailedPresenter lists every branch", func(t *testing.T) {
+		out := govalidator.JSONDetailedPresenter(".")(context.Background(), []string{"$"}, govalidator.AnyOfError{Branches: branches})
+
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal([]byte(out), &decoded))
+		assert.Equal(t, "AnyOfError", decoded["type"])
+		assert.Equal(t, []any{
+			map[string]any{
+				"index":  0.0,
+				"errors": []any{map[string]any{"path": "$.a", "message": "required", "type": "RequiredError"}},
+			},
+			map[string]any{
+				"index":  1.0,
+				"errors": []any{map[string]any{"path": "$", "message": "not a string", "type": "NotAStringError"}},
+			},
+		}, decoded["branches"])
+	})
+
+	t.Run("JSONDetailedPresenter includes matched branches", func(t *testing.T) {
+		out := govalidator.JSONDetailedPresenter(".")(context.Background(), []string{"$"}, govalidator.ExactlyOneOfError{Matched: []int{0, 2}})
+
+		assert.Contains(t, out, `"matched":[0,2]`)
+		assert.Contains(t, out, `"type":"ExactlyOneOfError"`)
+	})
+
+	t.Run("JSONDetailedPresenter handles AllOfError", func(t *testing.T) {
+		out := govalidator.JSONDetailedPresenter(".")(context.Background(), []string{"$"}, govalidator.AllOfError{Branches: branches[:1]})
+
+		assert.Contains(t, out, `"type":"AllOfError"`)
+		assert.Contains(t, out, `"branches":[{"errors":[{"message":"required","path":"$.a","type":"RequiredError"}],"index":0}]`)
+	})
+
+	t.Run("DetailedErrorPresenter", func(t *testing.T) {
+		presenter := govalidator.DetailedErrorPresenter()
+		ctx := context.Background()
+
+		assert.Equal(t, "value must match at least one of the allowed schemas",
+			presenter(ctx, nil, govalidator.AnyOfError{Branches: branches}))
+		assert.Equal(t, "value must match exactly one of the allowed schemas (matched 2)",
+			presenter(ctx, nil, govalidator.ExactlyOneOfError{Matched: []int{0, 1}}))
+		assert.Equal(t, "value must match all of the required schemas (1 rejected)",
+			presenter(ctx, nil, govalidator.AllOfError{Branches: branches[:1]}))
+	})
+}
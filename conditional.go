@@ -0,0 +1,291 @@
+package govalidator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// fieldCondition checks a field's presence against other fields of the same object.
+// kind, paths and value record how the condition was created so that it can be exported.
+type fieldCondition struct {
+	kind  string
+	paths []string
+	value any
+	check conditionCheck
+}
+
+// keyCondition is a conditional requirement of a name that is not declared as a field.
+type keyCondition struct {
+	name      string
+	condition fieldCondition
+}
+
+// conditionCheck evaluates a condition. present is false when the field is missing or null.
+type conditionCheck func(field string, present bool, object map[string]any) []error
+
+// ConditionalRequiredError is returned when a field is missing although another field requires it.
+// Trigger names the field that caused the requirement and Condition describes its state.
+type ConditionalRequiredError struct {
+	Field     string
+	Trigger   string
+	Condition string
+}
+
+// MutuallyExclusiveError is returned when a field is present together with a field it excludes.
+type MutuallyExclusiveError struct {
+	Field    string
+	Conflict string
+}
+
+// Error returns the error message.
+func (e ConditionalRequiredError) Error() string {
+	return fmt.Sprintf("required when %s %s", e.Trigger, e.Condition)
+}
+
+// Error returns the error message.
+func (e MutuallyExclusiveError) Error() string {
+	return fmt.Sprintf("cannot be used together with %s", e.Conflict)
+}
+
+// RequiredIf makes the field required when the field at path equals value.
+// The path is relative to the object containing the field; nested fields are separated by dots.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	Object(map[string]*Schema{
+//	    "enabled":  NewSchema(IsBooleanValidator).Required(),
+//	    "certFile": NewSchema(IsStringValidator).RequiredIf("enabled", true),
+//	})
+func (s *Schema) RequiredIf(path string, value any) *Schema {
+	s.conditions = append(s.conditions, fieldCondition{
+		kind:  "required_if",
+		paths: []string{path},
+		value: value,
+		check: func(field string, present bool, object map[string]any) []error {
+			actual, ok := lookupPath(object, path)
+			if present || !ok || !valuesEqual(actual, value) {
+				return nil
+			}
+			return []error{ConditionalRequiredError{
+				Field:     field,
+				Trigger:   path,
+				Condition: fmt.Sprintf("is %v", value),
+			}}
+		},
+	})
+	return s
+}
+
+// RequiredUnless makes the field required unless the field at path equals value.
+// A missing field at path does not equal any value, so it requires the field too.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	Object(map[string]*Schema{
+//	    "plan":    NewSchema(IsStringValidator).Required(),
+//	    "billing": NewSchema(IsStringValidator).RequiredUnless("plan", "free"),
+//	})
+func (s *Schema) RequiredUnless(path string, value any) *Schema {
+	s.conditions = append(s.conditions, fieldCondition{
+		kind:  "required_unless",
+		paths: []string{path},
+		value: value,
+		check: func(field string, present bool, object map[string]any) []error {
+			actual, ok := lookupPath(object, path)
+			if present || (ok && valuesEqual(actual, value)) {
+				return nil
+			}
+			return []error{ConditionalRequiredError{
+				Field:     field,
+				Trigger:   path,
+				Condition: fmt.Sprintf("is not %v", value),
+			}}
+		},
+	})
+	return s
+}
+
+// RequiredWith makes the field required when any of the fields at the given paths is present.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	NewSchema(IsStringValidator).RequiredWith("password")
+func (s *Schema) RequiredWith(paths ...string) *Schema {
+	s.conditions = append(s.conditions, requiredWithCondition(paths...))
+	return s
+}
+
+// requiredWithCondition requires a field when any of the fields at the given paths is present.
+func requiredWithCondition(paths ...string) fieldCondition {
+	return fieldCondition{
+		kind:  "required_with",
+		paths: paths,
+		check: func(field string, present bool, object map[string]any) []error {
+			if present {
+				return nil
+			}
+			for _, path := range paths {
+				if isPresent(object, path) {
+					return []error{ConditionalRequiredError{Field: field, Trigger: path, Condition: "is present"}}
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// RequiredWithout makes the field required when any of the fields at the given paths is missing or null.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	NewSchema(IsStringValidator).RequiredWithout("phone")
+func (s *Schema) RequiredWithout(paths ...string) *Schema {
+	s.conditions = append(s.conditions, fieldCondition{
+		kind:  "required_without",
+		paths: paths,
+		check: func(field string, present bool, object map[string]any) []error {
+			if present {
+				return nil
+			}
+			for _, path := range paths {
+				if !isPresent(object, path) {
+					return []error{ConditionalRequiredError{Field: field, Trigger: path, Condition: "is absent"}}
+				}
+			}
+			return nil
+		},
+	})
+	return s
+}
+
+// MutuallyExclusive rejects the field when any of the fields at the given paths is present as well.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	NewSchema(IsStringValidator).MutuallyExclusive("token")
+func (s *Schema) MutuallyExclusive(paths ...string) *Schema {
+	s.conditions = append(s.conditions, fieldCondition{
+		kind:  "mutually_exclusive",
+		paths: paths,
+		check: func(field string, present bool, object map[string]any) []error {
+			if !present {
+				return nil
+			}
+			var errs []error
+			for _, path := range paths {
+				if isPresent(object, path) {
+					errs = append(errs, MutuallyExclusiveError{Field: field, Conflict: path})
+				}
+			}
+			return errs
+		},
+	})
+	return s
+}
+
+// RequiredIf makes the field required when the field at path equals value.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("certFile").RequiredIf("enabled", true).WithValidators(IsStringValidator)
+func (f *Field) RequiredIf(path string, value any) *Field {
+	f.schema.RequiredIf(path, value)
+	return f
+}
+
+// RequiredUnless makes the field required unless the field at path equals value.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("billing").RequiredUnless("plan", "free")
+func (f *Field) RequiredUnless(path string, value any) *Field {
+	f.schema.RequiredUnless(path, value)
+	return f
+}
+
+// RequiredWith makes the field required when any of the given fields is present.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("password_confirm").RequiredWith("password")
+func (f *Field) RequiredWith(paths ...string) *Field {
+	f.schema.RequiredWith(paths...)
+	return f
+}
+
+// RequiredWithout makes the field required when any of the given fields is missing or null.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("email").RequiredWithout("phone")
+func (f *Field) RequiredWithout(paths ...string) *Field {
+	f.schema.RequiredWithout(paths...)
+	return f
+}
+
+// MutuallyExclusive rejects the field when any of the given fields is present as well.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("password").MutuallyExclusive("token")
+func (f *Field) MutuallyExclusive(paths ...string) *Field {
+	f.schema.MutuallyExclusive(paths...)
+	return f
+}
+
+// lookupPath returns the value at a dot-separated path inside the object.
+func lookupPath(object map[string]any, path string) (any, bool) {
+	var current any = object
+	for _, segment := range strings.Split(path, ".") {
+		m, ok := adaptValue(current).(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// isPresent reports whether the value at path exists and is not null.
+func isPresent(object map[string]any, path string) bool {
+	value, ok := lookupPath(object, path)
+	return ok && value != nil
+}
+
+// valuesEqual compares two values, treating numbers of different types as equal by value.
+func valuesEqual(a, b any) bool {
+	if x, ok := numericValue(a); ok {
+		y, ok := numericValue(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// validateConditions evaluates the conditional requirements of a field.
+func (sv *SchemaValidator) validateConditions(
+	fieldCtx *ValidationContext,
+	fieldName string,
+	fieldValue any,
+	currentMap map[string]any,
+	fieldSchema *Schema,
+) {
+	for _, condition := range fieldSchema.conditions {
+		for _, err := range condition.check(fieldName, fieldValue != nil, currentMap) {
+			fieldCtx.errorCollector.Collect(fieldCtx.path, err)
+		}
+	}
+}
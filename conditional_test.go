@@ -0,0 +1,167 @@
+package govalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestField_RequiredIf(t *testing.T) {
+	tls := govalidator.NewSchema().WithFields(
+		govalidator.NewField("enabled").Required().WithValidators(govalidator.IsBooleanValidator),
+		govalidator.NewField("certFile").RequiredIf("enabled", true).WithValidators(govalidator.IsStringValidator),
+	)
+
+	t.Run("not required when condition does not hold", func(t *testing.T) {
+		valid, errs := tls.Validate(context.Background(), map[string]any{"enabled": false})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("required when condition holds", func(t *testing.T) {
+		valid, errs := tls.Validate(context.Background(), map[string]any{"enabled": true})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.certFile": {"required when enabled is true"}}, errs)
+	})
+
+	t.Run("null does not satisfy requirement", func(t *testing.T) {
+		valid, _ := tls.Validate(context.Background(), map[string]any{"enabled": true, "certFile": nil})
+
+		assert.False(t, valid)
+	})
+
+	t.Run("satisfied when present", func(t *testing.T) {
+		valid, errs := tls.Validate(context.Background(), map[string]any{"enabled": true, "certFile": "/etc/cert.pem"})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("supports nested trigger paths", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("tls").WithValidators(govalidator.IsMapValidator),
+			govalidator.NewField("certFile").RequiredIf("tls.enabled", true),
+		)
+
+		_, errs := schema.Validate(context.Background(), map[string]any{"tls": map[string]any{"enabled": true}})
+		assert.Equal(t, map[string][]string{"$.certFile": {"required when tls.enabled is true"}}, errs)
+
+		valid, _ := schema.Validate(context.Background(), map[string]any{"tls": map[string]any{}})
+		assert.True(t, valid)
+
+		_, errs = schema.Validate(context.Background(), map[string]any{"tls": "on"})
+		assert.NotContains(t, errs, "$.certFile")
+	})
+
+	t.Run("compares numbers by value", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"port": govalidator.NewSchema().RequiredIf("mode", 2),
+		})
+
+		_, errs := schema.Validate(context.Background(), map[string]any{"mode": 2.0})
+
+		assert.Equal(t, map[string][]string{"$.port": {"required when mode is 2"}}, errs)
+	})
+}
+
+func TestField_RequiredWith(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("password"),
+		govalidator.NewField("password_confirm").RequiredWith("password"),
+	)
+
+	_, errs := schema.Validate(context.Background(), map[string]any{"password": "x"})
+	assert.Equal(t, map[string][]string{"$.password_confirm": {"required when password is present"}}, errs)
+
+	valid, _ := schema.Validate(context.Background(), map[string]any{})
+	assert.True(t, valid)
+
+	valid, _ = schema.Validate(context.Background(), map[string]any{"password": "x", "password_confirm": "x"})
+	assert.True(t, valid)
+}
+
+func TestField_RequiredWithout(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("phone"),
+		govalidator.NewField("email").RequiredWithout("phone"),
+	)
+
+	_, errs := schema.Validate(context.Background(), map[string]any{"phone": nil})
+	assert.Equal(t, map[string][]string{"$.email": {"required when phone is absent"}}, errs)
+
+	valid, _ := schema.Validate(context.Background(), map[string]any{"phone": "123"})
+	assert.True(t, valid)
+
+	valid, _ = schema.Validate(context.Background(), map[string]any{"email": "a@b.c"})
+	assert.True(t, valid)
+}
+
+func TestField_RequiredUnless(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("plan"),
+		govalidator.NewField("billing").RequiredUnless("plan", "free"),
+	)
+
+	_, errs := schema.Validate(context.Background(), map[string]any{"plan": "pro"})
+	assert.Equal(t, map[string][]string{"$.billing": {"required when plan is not free"}}, errs)
+
+	_, errs = schema.Validate(context.Background(), map[string]any{})
+	assert.Equal(t, map[string][]string{"$.billing": {"required when plan is not free"}}, errs)
+
+	valid, _ := schema.Validate(context.Background(), map[string]any{"plan": "free"})
+	assert.True(t, valid)
+
+	valid, _ = schema.Validate(context.Background(), map[string]any{"plan": "pro", "billing": "card"})
+	assert.True(t, valid)
+}
+
+func TestField_MutuallyExclusive(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("password").MutuallyExclusive("token", "certificate"),
+	)
+
+	_, errs := schema.Validate(context.Background(), map[string]any{"password": "x", "token": "y", "certificate": "z"})
+	assert.Equal(t, map[string][]string{"$.password": {
+		"cannot be used together with token",
+		"cannot be used together with certificate",
+	}}, errs)
+
+	valid, _ := schema.Validate(context.Background(), map[string]any{"token": "y"})
+	assert.True(t, valid)
+
+	valid, _ = schema.Validate(context.Background(), map[string]any{"password": "x", "token": nil})
+	assert.True(t, valid)
+}
+
+func TestConditionalFields_ToDefinition(t *testing.T) {
+	schema := govalidator.Object(map[string]*govalidator.Schema{
+		"enabled":  govalidator.NewSchema(),
+		"certFile": govalidator.NewSchema().RequiredIf("enabled", true),
+	})
+
+	_, errs := govalidator.NewBasicValidator(
+		govalidator.PathPresenter("."),
+		govalidator.SimpleErrorPresenter(),
+	).Validate(context.Background(), map[string]any{"enabled": true}, schema.ToDefinition())
+
+	assert.Equal(t, map[string][]string{"$": {"certFile: required when enabled is true"}}, errs)
+}
+
+func TestConditionalErrors_Presenters(t *testing.T) {
+	ctx := context.Background()
+	required := govalidator.ConditionalRequiredError{Field: "certFile", Trigger: "enabled", Condition: "is true"}
+	exclusive := govalidator.MutuallyExclusiveError{Field: "password", Conflict: "token"}
+
+	assert.Equal(t, "field 'certFile' is required when 'enabled' is true", govalidator.DetailedErrorPresenter()(ctx, nil, required))
+	assert.Equal(t, "field 'password' cannot be used together with 'token'", govalidator.DetailedErrorPresenter()(ctx, nil, exclusive))
+	assert.JSONEq(t,
+		`{"path":"$.certFile","message":"required when enabled is true","type":"ConditionalRequiredError","field":"certFile","trigger":"enabled","condition":"is true"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "certFile"}, required))
+	assert.JSONEq(t,
+		`{"path":"$.password","message":"cannot be used together with token","type":"MutuallyExclusiveError","field":"password","conflict":"token"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "password"}, exclusive))
+}
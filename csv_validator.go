@@ -0,0 +1,349 @@
+package govalidator
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// CSVHeaderError is returned when the header row of a CSV file cannot be mapped to the schema.
+type CSVHeaderError struct {
+	Reason string
+}
+
+// Error returns the error message.
+func (e CSVHeaderError) Error() string {
+	return "invalid CSV header: " + e.Reason
+}
+
+// CSVColumnCountError is reported for rows with more or fewer cells than the header.
+type CSVColumnCountError struct {
+	Expected int
+	Actual   int
+}
+
+// Error returns the error message.
+func (e CSVColumnCountError) Error() string {
+	return fmt.Sprintf("expected %d columns, got %d", e.Expected, e.Actual)
+}
+
+// csvCoercions converts the cells of typed columns, keyed by JSON Schema type.
+var csvCoercions = map[string]Transformer{
+	"integer": StringToIntTransformer,
+	"number":  StringToFloatTransformer,
+	"boolean": StringToBoolTransformer,
+}
+
+// CSVRowResult is the outcome of validating one row of a CSV file.
+type CSVRowResult struct {
+	// Row is the line the row starts on, so the header is usually row 1
+	Row int
+
+	// Record holds the cells of the row as read
+	Record []string
+
+	// Value is the row as normalized: cells keyed by field name, without empty
+	// cells, converted to the types of their fields and with defaults applied.
+	// Cells that do not convert keep their text.
+	Value map[string]any
+
+	Valid  bool
+	Errors []string
+}
+
+// CSVValidator validates the rows of CSV files against an object schema,
+// mapping header names to Schema.Fields.
+//
+// Header names match field names regardless of case and surrounding spaces,
+// or one of the aliases given with WithAlias. Columns without a field keep
+// their header name, so the schema's Extra mode decides whether they are
+// allowed. Empty cells are treated as absent fields, and cells of fields
+// whose validators include a type of integer, number or boolean are
+// converted to that type before validation, with a CoercionError for cells
+// that do not parse.
+//
+// Example:
+//
+//	v := NewCSVValidator(employeeSchema).
+//	    WithAlias("email", "E-mail", "mail").
+//	    RequireColumns("department").
+//	    WithRejected(rejectedFile, "errors")
+//	err := v.Validate(ctx, file, func(result CSVRowResult) error {
+//	    for _, e := range result.Errors {
+//	        log.Println(e) // row 17, column "Salary": cannot convert "12k" to number
+//	    }
+//	    return nil
+//	})
+type CSVValidator struct {
+	schema          *Schema
+	validator       *SchemaValidator
+	errorPresenter  PresenterFunc
+	aliases         map[string]string
+	requiredColumns []string
+	comma           rune
+	rejected        io.Writer
+	errorColumn     string
+}
+
+// NewCSVValidator creates a CSV validator for rows described by the object schema.
+func NewCSVValidator(schema *Schema) *CSVValidator {
+	return &CSVValidator{
+		schema:         csvRowSchema(schema),
+		validator:      NewSchemaValidator(PathPresenter("."), SimpleErrorPresenter()),
+		errorPresenter: SimpleErrorPresenter(),
+		aliases:        map[string]string{},
+		comma:          ',',
+	}
+}
+
+// WithAlias makes columns with any of the header names fill the field.
+// Returns the validator for method chaining.
+func (v *CSVValidator) WithAlias(field string, headers ...string) *CSVValidator {
+	for _, header := range headers {
+		v.aliases[csvHeaderKey(header)] = field
+	}
+	return v
+}
+
+// RequireColumns rejects files without a column for each of the fields, even
+// if the fields are optional. Columns of required fields are always required.
+// Returns the validator for method chaining.
+func (v *CSVValidator) RequireColumns(fields ...string) *CSVValidator {
+	v.requiredColumns = append(v.requiredColumns, fields...)
+	return v
+}
+
+// WithErrorPresenter sets the presenter of error messages, SimpleErrorPresenter by default.
+// Returns the validator for method chaining.
+func (v *CSVValidator) WithErrorPresenter(presenter PresenterFunc) *CSVValidator {
+	v.errorPresenter = presenter
+	return v
+}
+
+// WithComma sets the field delimiter, ',' by default.
+// Returns the validator for method chaining.
+func (v *CSVValidator) WithComma(comma rune) *CSVValidator {
+	v.comma = comma
+	return v
+}
+
+// WithRejected writes the header and every invalid row to w as CSV, with the
+// row's errors joined by "; " in an extra column named errorColumn.
+// Returns the validator for method chaining.
+func (v *CSVValidator) WithRejected(w io.Writer, errorColumn string) *CSVValidator {
+	v.rejected = w
+	v.errorColumn = errorColumn
+	return v
+}
+
+// Validate reads CSV data with a header row and validates the rows one at a
+// time, passing each result to the handler, which may be nil. Errors are
+// reported as `row 17, column "email": message`, naming the column by its
+// header. A header that cannot be mapped returns a CSVHeaderError before any
+// row is read; malformed CSV returns the *csv.ParseError. Returning an error
+// from the handler, or cancelling the context, stops validation with that error.
+func (v *CSVValidator) Validate(ctx context.Context, r io.Reader, handler func(result CSVRowResult) error) error {
+	reader := csv.NewReader(r)
+	reader.Comma = v.comma
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return CSVHeaderError{Reason: "missing header row"}
+	}
+	if err != nil {
+		return err
+	}
+	fields, err := v.mapHeader(header)
+	if err != nil {
+		return err
+	}
+
+	var rejected *csv.Writer
+	if v.rejected != nil {
+		rejected = csv.NewWriter(v.rejected)
+		rejected.Comma = v.comma
+		if err := rejected.Write(append(header[:len(header):len(header)], v.errorColumn)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		row, _ := reader.FieldPos(0)
+
+		result := v.validateRow(ctx, row, record, header, fields)
+		if rejected != nil && !result.Valid {
+			if err := rejected.Write(append(record[:len(record):len(record)], strings.Join(result.Errors, "; "))); err != nil {
+				return err
+			}
+		}
+		if handler != nil {
+			if err := handler(result); err != nil {
+				return err
+			}
+		}
+	}
+
+	if rejected != nil {
+		rejected.Flush()
+		return rejected.Error()
+	}
+	return nil
+}
+
+// mapHeader returns the field name of each column, checking that no field has
+// two columns and that every required column is present.
+func (v *CSVValidator) mapHeader(header []string) ([]string, error) {
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	byKey := make(map[string]string, len(v.schema.Fields))
+	for name := range v.schema.Fields {
+		byKey[csvHeaderKey(name)] = name
+	}
+	for alias, name := range v.aliases {
+		byKey[alias] = name
+	}
+
+	fields := make([]string, len(header))
+	seen := make(map[string]bool, len(header))
+	for i, column := range header {
+		name, ok := byKey[csvHeaderKey(column)]
+		if !ok {
+			name = strings.TrimSpace(column)
+		}
+		if seen[name] {
+			return nil, CSVHeaderError{Reason: fmt.Sprintf("duplicate column %q", column)}
+		}
+		seen[name] = true
+		fields[i] = name
+	}
+
+	var missing []string
+	for name, field := range v.schema.Fields {
+		if field != nil && (field.required || field.mustBePresent) && !seen[name] {
+			missing = append(missing, name)
+		}
+	}
+	for _, name := range v.requiredColumns {
+		if !seen[name] && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, CSVHeaderError{Reason: fmt.Sprintf("missing required columns %q", missing)}
+	}
+	return fields, nil
+}
+
+// validateRow validates one row, keyed by the field names of its columns.
+func (v *CSVValidator) validateRow(ctx context.Context, row int, record, header, fields []string) CSVRowResult {
+	columns := make(map[string]string, len(fields))
+	for i, name := range fields {
+		columns[name] = strings.TrimSpace(header[i])
+	}
+	collector := NewFlatErrorCollector(ctx, func(ctx context.Context, path []string, err error) string {
+		message := v.errorPresenter(ctx, path, err)
+		if len(path) < 2 {
+			return fmt.Sprintf("row %d: %s", row, message)
+		}
+		column, ok := columns[path[1]]
+		if !ok {
+			column = path[1]
+		}
+		return fmt.Sprintf("row %d, column %q: %s", row, column, message)
+	})
+
+	if len(record) != len(header) {
+		collector.Collect([]string{"$"}, CSVColumnCountError{Expected: len(header), Actual: len(record)})
+		return CSVRowResult{Row: row, Record: record, Errors: collector.GetFlatErrors()}
+	}
+
+	value := make(map[string]any, len(record))
+	for i, cell := range record {
+		if cell != "" {
+			value[fields[i]] = cell
+		}
+	}
+
+	valCtx := &ValidationContext{
+		ctx:            ctx,
+		path:           []string{"$"},
+		errorCollector: collector,
+		pathPresenter:  v.validator.pathPresenter,
+		errorPresenter: v.validator.errorPresenter,
+		normalize:      true,
+	}
+	if normalized, ok := v.validator.validateValue(valCtx, value, v.schema).(map[string]any); ok {
+		value = normalized
+	}
+
+	return CSVRowResult{
+		Row:    row,
+		Record: record,
+		Value:  value,
+		Valid:  !collector.HasErrors(),
+		Errors: collector.GetFlatErrors(),
+	}
+}
+
+// csvRowSchema copies the schema, converting the cells of typed fields first.
+// Fields without a schema accept any cell.
+func csvRowSchema(schema *Schema) *Schema {
+	row := *schema
+	row.Fields = make(map[string]*Schema, len(schema.Fields))
+	for name, field := range schema.Fields {
+		if field == nil {
+			row.Fields[name] = NewSchema()
+			continue
+		}
+		coerce := csvCoercion(field)
+		if coerce == nil {
+			row.Fields[name] = field
+			continue
+		}
+		coerced := *field
+		coerced.transformers = append([]Transformer{TrimSpaceTransformer, coerce}, field.transformers...)
+		row.Fields[name] = &coerced
+	}
+	return &row
+}
+
+// csvCoercion returns the transformer converting cells to the type of the field, if it has one.
+func csvCoercion(field *Schema) Transformer {
+	if field == nil {
+		return nil
+	}
+	for _, validator := range field.Validators {
+		info, ok := describeValidator(validator)
+		if !ok || !info.builtin {
+			continue
+		}
+		if coerce, typed := csvCoercions[jsonSchemaTypeNames[info.name]]; typed {
+			return coerce
+		}
+	}
+	return nil
+}
+
+// csvHeaderKey normalizes a header name for matching.
+func csvHeaderKey(header string) string {
+	return strings.ToLower(strings.TrimSpace(header))
+}
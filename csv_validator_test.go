@@ -0,0 +1,286 @@
+package govalidator_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// csvInvalidEmail is the message of EmailValidator for values without an @.
+const csvInvalidEmail = "invalid email address: mail: missing '@' or angle-addr"
+
+func csvEmployeeSchema() *govalidator.Schema {
+	return govalidator.Object(map[string]*govalidator.Schema{
+		"email":  govalidator.NewSchema(govalidator.IsStringValidator, govalidator.EmailValidator).Required(),
+		"age":    govalidator.NewSchema(govalidator.IsIntegerValidator),
+		"salary": govalidator.NewSchema(govalidator.NumberValidator, govalidator.MinFloatValidator(0)),
+		"active": govalidator.NewSchema(govalidator.IsBooleanValidator),
+		"note":   govalidator.NewSchema(govalidator.IsStringValidator),
+	}).WithExtra(govalidator.ExtraForbid)
+}
+
+// validateCSV validates the CSV data and returns the results of all rows.
+func validateCSV(t *testing.T, v *govalidator.CSVValidator, data string) ([]govalidator.CSVRowResult, error) {
+	t.Helper()
+	var results []govalidator.CSVRowResult
+	err := v.Validate(context.Background(), strings.NewReader(data), func(result govalidator.CSVRowResult) error {
+		results = append(results, result)
+		return nil
+	})
+	return results, err
+}
+
+func TestCSVValidator_Validate(t *testing.T) {
+	t.Run("typed columns", func(t *testing.T) {
+		results, err := validateCSV(t, govalidator.NewCSVValidator(csvEmployeeSchema()),
+			"Email,Age,Salary,Active,Note\n"+
+				"ann@example.com, 30 ,75000.50,true,007\n"+
+				"bob@example.com,,,,\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, govalidator.CSVRowResult{
+			Row:    2,
+			Record: []string{"ann@example.com", " 30 ", "75000.50", "true", "007"},
+			Value:  map[string]any{"email": "ann@example.com", "age": 30, "salary": 75000.5, "active": true, "note": "007"},
+			Valid:  true,
+			Errors: []string{},
+		}, results[0])
+		assert.True(t, results[1].Valid)
+		assert.Equal(t, map[string]any{"email": "bob@example.com"}, results[1].Value)
+	})
+
+	t.Run("row and column errors", func(t *testing.T) {
+		results, err := validateCSV(t, govalidator.NewCSVValidator(csvEmployeeSchema()),
+			"Email,Age,Salary,Active\n"+
+				"bob,1.5,-10,maybe\n"+
+				",40,x,false\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.False(t, results[0].Valid)
+		assert.Equal(t, []string{
+			`row 2, column "Active": cannot convert "maybe" to boolean`,
+			`row 2, column "Age": cannot convert "1.5" to integer`,
+			`row 2, column "Email": ` + csvInvalidEmail,
+			`row 2, column "Salary": value is less than min`,
+		}, results[0].Errors)
+		assert.Equal(t, []string{
+			`row 3, column "Email": required`,
+			`row 3, column "Salary": cannot convert "x" to number`,
+		}, results[1].Errors)
+		assert.Equal(t, map[string]any{"age": 40, "salary": "x", "active": false}, results[1].Value)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"email":  govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+			"active": govalidator.NewSchema(govalidator.IsBooleanValidator).Default(true),
+		})
+
+		results, err := validateCSV(t, govalidator.NewCSVValidator(schema), "email,active\nann@example.com,\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, map[string]any{"email": "ann@example.com", "active": true}, results[0].Value)
+	})
+
+	t.Run("fields without schema", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"email": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+			"note":  nil,
+		}).WithExtra(govalidator.ExtraForbid)
+
+		results, err := validateCSV(t, govalidator.NewCSVValidator(schema), "email,note\nann@example.com,x\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Valid)
+		assert.Equal(t, map[string]any{"email": "ann@example.com", "note": "x"}, results[0].Value)
+	})
+
+	t.Run("header aliases", func(t *testing.T) {
+		v := govalidator.NewCSVValidator(csvEmployeeSchema()).WithAlias("email", "E-mail", "Mail Address")
+
+		results, err := validateCSV(t, v, "\ufeff E-mail ,age\nnope,3\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, map[string]any{"email": "nope", "age": 3}, results[0].Value)
+		assert.Equal(t, []string{`row 2, column "E-mail": ` + csvInvalidEmail}, results[0].Errors)
+	})
+
+	t.Run("unknown columns", func(t *testing.T) {
+		results, err := validateCSV(t, govalidator.NewCSVValidator(csvEmployeeSchema()), "email,Team\nann@example.com,blue\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, []string{"row 2: unexpected field Team"}, results[0].Errors)
+	})
+
+	t.Run("column counts", func(t *testing.T) {
+		results, err := validateCSV(t, govalidator.NewCSVValidator(csvEmployeeSchema()),
+			"email,age\nann@example.com\nbob@example.com,1,2\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, govalidator.CSVRowResult{Row: 2, Record: []string{"ann@example.com"}, Errors: []string{"row 2: expected 2 columns, got 1"}}, results[0])
+		assert.Equal(t, []string{"row 3: expected 2 columns, got 3"}, results[1].Errors)
+	})
+
+	t.Run("row numbers", func(t *testing.T) {
+		results, err := validateCSV(t, govalidator.NewCSVValidator(csvEmployeeSchema()),
+			"email,note\nann@example.com,\"two\nlines\"\n\nbad,x\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, 2, results[0].Row)
+		assert.Equal(t, []string{`row 5, column "email": ` + csvInvalidEmail}, results[1].Errors)
+	})
+
+	t.Run("delimiters and presenters", func(t *testing.T) {
+		v := govalidator.NewCSVValidator(csvEmployeeSchema()).
+			WithComma(';').
+			WithErrorPresenter(govalidator.DetailedErrorPresenter())
+
+		results, err := validateCSV(t, v, "email;age\n;3\n")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, []string{`row 2, column "email": this field is required`}, results[0].Errors)
+	})
+
+	t.Run("nil handlers", func(t *testing.T) {
+		err := govalidator.NewCSVValidator(csvEmployeeSchema()).Validate(context.Background(), strings.NewReader("email\nx\n"), nil)
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestCSVValidator_Header(t *testing.T) {
+	tests := []struct {
+		name string
+		v    *govalidator.CSVValidator
+		data string
+		want string
+	}{
+		{
+			name: "missing header row",
+			v:    govalidator.NewCSVValidator(csvEmployeeSchema()),
+			data: "",
+			want: "invalid CSV header: missing header row",
+		},
+		{
+			name: "missing required columns",
+			v:    govalidator.NewCSVValidator(csvEmployeeSchema()).RequireColumns("salary", "note", "salary"),
+			data: "age,note\n",
+			want: `invalid CSV header: missing required columns ["email" "salary"]`,
+		},
+		{
+			name: "present columns",
+			v:    govalidator.NewCSVValidator(csvEmployeeSchema()).RequireColumns("age"),
+			data: "Email,Age\n",
+		},
+		{
+			name: "duplicate columns",
+			v:    govalidator.NewCSVValidator(csvEmployeeSchema()).WithAlias("email", "mail"),
+			data: "email,Mail\n",
+			want: `invalid CSV header: duplicate column "Mail"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.v.Validate(context.Background(), strings.NewReader(tt.data), nil)
+
+			if tt.want == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var headerErr govalidator.CSVHeaderError
+			assert.ErrorAs(t, err, &headerErr)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
+
+func TestCSVValidator_WithRejected(t *testing.T) {
+	var rejected bytes.Buffer
+	v := govalidator.NewCSVValidator(csvEmployeeSchema()).WithRejected(&rejected, "errors")
+
+	_, err := validateCSV(t, v, "email,age\nann@example.com,30\nbad,x\n,\"4,0\"\n")
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(&rejected).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"email", "age", "errors"},
+		{"bad", "x", `row 3, column "age": cannot convert "x" to integer; row 3, column "email": ` + csvInvalidEmail},
+		{"", "4,0", `row 4, column "age": cannot convert "4,0" to integer; row 4, column "email": required`},
+	}, records)
+}
+
+func TestCSVValidator_Errors(t *testing.T) {
+	t.Run("malformed CSV", func(t *testing.T) {
+		_, err := validateCSV(t, govalidator.NewCSVValidator(csvEmployeeSchema()), "email\n\"unterminated\n")
+
+		var parseErr *csv.ParseError
+		assert.ErrorAs(t, err, &parseErr)
+	})
+
+	t.Run("malformed header", func(t *testing.T) {
+		_, err := validateCSV(t, govalidator.NewCSVValidator(csvEmployeeSchema()), "\"email\n")
+
+		var parseErr *csv.ParseError
+		assert.ErrorAs(t, err, &parseErr)
+	})
+
+	t.Run("handler errors", func(t *testing.T) {
+		errStop := errors.New("stop")
+		calls := 0
+
+		err := govalidator.NewCSVValidator(csvEmployeeSchema()).Validate(context.Background(), strings.NewReader("email\na\nb\n"),
+			func(govalidator.CSVRowResult) error {
+				calls++
+				return errStop
+			})
+
+		assert.Equal(t, errStop, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := govalidator.NewCSVValidator(csvEmployeeSchema()).Validate(ctx, strings.NewReader("email\na\n"), nil)
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("rejected writer errors", func(t *testing.T) {
+		errWrite := errors.New("disk full")
+
+		err := govalidator.NewCSVValidator(csvEmployeeSchema()).
+			WithRejected(failingWriter{err: errWrite}, "errors").
+			Validate(context.Background(), strings.NewReader("email\na\n"), nil)
+
+		assert.ErrorIs(t, err, errWrite)
+	})
+}
+
+// failingWriter fails every write.
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write([]byte) (int, error) {
+	return 0, w.err
+}
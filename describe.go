@@ -0,0 +1,340 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// ValidatorDescriptor describes a validator: a stable snake_case name such as
+// "min_length", the parameters it was created with and a human description
+// such as "must be at least 3 characters long".
+//
+// ContextValidator is a plain function, so descriptors are obtained with
+// DescribeValidator. Every built-in validator has one; custom validators can
+// attach one with DescribedValidator.
+type ValidatorDescriptor interface {
+	Name() string
+	Params() []ValidatorParam
+	Description() string
+}
+
+// ValidatorParam is a named parameter of a validator, in the order of the constructor arguments.
+type ValidatorParam struct {
+	Name  string
+	Value any
+}
+
+// validatorInfo is the ValidatorDescriptor of built-in and described validators and rules.
+// Constructors attach it with describe or describeObjectRule when they create the function.
+type validatorInfo struct {
+	name        string
+	params      []ValidatorParam
+	description string
+	builtin     bool
+}
+
+// describedValidator is a validator together with its descriptor. Its validate
+// method value is the ContextValidator handed out by describe.
+type describedValidator struct {
+	info      validatorInfo
+	validator ContextValidator
+}
+
+// describedRule is an object rule together with its descriptor. Its check
+// method value is the ObjectRule handed out by describeObjectRule.
+type describedRule struct {
+	info validatorInfo
+	rule ObjectRule
+}
+
+// descriptorProbe asks a described validator or rule for its descriptor instead of validating.
+type descriptorProbe struct {
+	info  validatorInfo
+	found bool
+}
+
+// descriptorProbeKey is the context key passing a *descriptorProbe to described rules.
+type descriptorProbeKey struct{}
+
+// describe attaches a descriptor to a newly created validator.
+func describe(validator ContextValidator, info validatorInfo) ContextValidator {
+	return (&describedValidator{info: info, validator: validator}).validate
+}
+
+// describeObjectRule attaches a descriptor to a newly created object rule.
+func describeObjectRule(rule ObjectRule, info validatorInfo) ObjectRule {
+	return (&describedRule{info: info, rule: rule}).check
+}
+
+// validate runs the validator, or reports the descriptor to a probe.
+func (d *describedValidator) validate(ctx context.Context, value any) (twigBlock bool, errs []error) {
+	if probe, ok := value.(*descriptorProbe); ok {
+		probe.info, probe.found = d.info, true
+		return false, nil
+	}
+	return d.validator(ctx, value)
+}
+
+// check runs the rule, or reports the descriptor to a probe.
+// Objects are never nil during validation, so only a nil object looks for a probe.
+func (d *describedRule) check(ctx context.Context, object map[string]any) []PathError {
+	if object == nil {
+		if probe, ok := ctx.Value(descriptorProbeKey{}).(*descriptorProbe); ok {
+			probe.info, probe.found = d.info, true
+			return nil
+		}
+	}
+	return d.rule(ctx, object)
+}
+
+// Method values of one method share their code pointer, which tells described
+// validators and rules apart from any other function without calling it.
+var (
+	describedValidatorPointer = funcPointer((&describedValidator{}).validate)
+	describedRulePointer      = funcPointer((&describedRule{}).check)
+)
+
+// builtinDescription holds the parameter names and the description of a built-in validator or rule.
+type builtinDescription struct {
+	params   []string
+	describe func(args []any) string
+}
+
+// fixedDescription returns a description function ignoring the arguments.
+func fixedDescription(description string) func([]any) string {
+	return func([]any) string { return description }
+}
+
+// builtinDescriptions describes the built-in validators and rules by name.
+var builtinDescriptions = map[string]builtinDescription{
+	"is_string":    {describe: fixedDescription("must be a string")},
+	"is_integer":   {describe: fixedDescription("must be an integer")},
+	"number":       {describe: fixedDescription("must be a number")},
+	"is_boolean":   {describe: fixedDescription("must be a boolean")},
+	"is_list":      {describe: fixedDescription("must be a list")},
+	"is_map":       {describe: fixedDescription("must be an object")},
+	"nullable":     {describe: fixedDescription("may be null")},
+	"non_nullable": {describe: fixedDescription("must not be null")},
+	"email":        {describe: fixedDescription("must be an email address")},
+	"uuid":         {describe: fixedDescription("must be a UUID")},
+	"url":          {describe: fixedDescription("must be a URL")},
+	"ipv4":         {describe: fixedDescription("must be an IPv4 address")},
+	"ipv6":         {describe: fixedDescription("must be an IPv6 address")},
+	"xid":          {describe: fixedDescription("must be an XID")},
+	"base64":       {describe: fixedDescription("must be base64 encoded")},
+	"json":         {describe: fixedDescription("must be a JSON document")},
+	"luhn":         {describe: fixedDescription("must pass the Luhn checksum")},
+	"lower_case":   {describe: fixedDescription("must be lower case")},
+	"upper_case":   {describe: fixedDescription("must be upper case")},
+	"time":         {describe: fixedDescription("must be an RFC 3339 time")},
+	"float": {params: []string{"max_precision"}, describe: func(args []any) string {
+		return fmt.Sprintf("must be a number with at most %v decimal places", args[0])
+	}},
+	"min_length": {params: []string{"min"}, describe: func(args []any) string {
+		return fmt.Sprintf("must be at least %v characters long", args[0])
+	}},
+	"max_length": {params: []string{"max"}, describe: func(args []any) string {
+		return fmt.Sprintf("must be at most %v characters long", args[0])
+	}},
+	"min_size": {params: []string{"min", "blocks"}, describe: func(args []any) string {
+		return fmt.Sprintf("must have at least %v items", args[0])
+	}},
+	"max_size": {params: []string{"max", "blocks"}, describe: func(args []any) string {
+		return fmt.Sprintf("must have at most %v items", args[0])
+	}},
+	"min_float": {params: []string{"min"}, describe: func(args []any) string {
+		return fmt.Sprintf("must be at least %v", args[0])
+	}},
+	"max_float": {params: []string{"max"}, describe: func(args []any) string {
+		return fmt.Sprintf("must be at most %v", args[0])
+	}},
+	"one_of": {params: []string{"options"}, describe: func(args []any) string {
+		options := args[0].([]any)
+		texts := make([]string, len(options))
+		for i, option := range options {
+			texts[i] = fmt.Sprint(option)
+		}
+		return "must be one of " + strings.Join(texts, ", ")
+	}},
+	"regex": {params: []string{"pattern"}, describe: func(args []any) string {
+		return fmt.Sprintf("must match %v", args[0])
+	}},
+	"equal_fields": {params: []string{"field", "other"}, describe: func(args []any) string {
+		return fmt.Sprintf("%v must equal %v", args[0], args[1])
+	}},
+	"less_than_field": {params: []string{"field", "other"}, describe: func(args []any) string {
+		return fmt.Sprintf("%v must be less than %v", args[0], args[1])
+	}},
+	"greater_than_field": {params: []string{"field", "other"}, describe: func(args []any) string {
+		return fmt.Sprintf("%v must be greater than %v", args[0], args[1])
+	}},
+}
+
+// builtinInfo describes a built-in validator or rule created with the arguments.
+func builtinInfo(name string, args ...any) validatorInfo {
+	builtin := builtinDescriptions[name]
+	params := make([]ValidatorParam, len(args))
+	for n, arg := range args {
+		params[n] = ValidatorParam{Name: builtin.params[n], Value: arg}
+	}
+	return validatorInfo{name: name, params: params, description: builtin.describe(args), builtin: true}
+}
+
+// Name returns the name of the validator.
+func (i validatorInfo) Name() string {
+	return i.name
+}
+
+// Params returns the parameters of the validator.
+func (i validatorInfo) Params() []ValidatorParam {
+	return i.params
+}
+
+// Description returns the human description of the validator.
+func (i validatorInfo) Description() string {
+	return i.description
+}
+
+// arg returns the value of the n-th parameter.
+func (i validatorInfo) arg(n int) any {
+	return i.params[n].Value
+}
+
+// paramMap returns the parameters keyed by name, or nil if there are none.
+func (i validatorInfo) paramMap() map[string]any {
+	if len(i.params) == 0 {
+		return nil
+	}
+	params := make(map[string]any, len(i.params))
+	for _, param := range i.params {
+		params[param.Name] = param.Value
+	}
+	return params
+}
+
+// NewValidatorDescriptor creates a descriptor for use with DescribedValidator.
+//
+// Example:
+//
+//	NewValidatorDescriptor("no_profanity", "must not contain profanity")
+func NewValidatorDescriptor(name, description string, params ...ValidatorParam) ValidatorDescriptor {
+	return validatorInfo{name: name, params: params, description: description}
+}
+
+// DescribedValidator attaches a descriptor to a custom validator, so that
+// DescribeValidator and schema exports can name and explain it.
+// The returned validator validates exactly like the given one.
+//
+// Example:
+//
+//	validator := DescribedValidator(
+//	    NewValidatorDescriptor("divisible_by", "must be divisible by 5", ValidatorParam{Name: "n", Value: 5}),
+//	    divisibleBy(5),
+//	)
+func DescribedValidator(descriptor ValidatorDescriptor, validator ContextValidator) ContextValidator {
+	return describe(validator, validatorInfo{
+		name:        descriptor.Name(),
+		params:      descriptor.Params(),
+		description: descriptor.Description(),
+	})
+}
+
+// DescribeValidator returns the descriptor of a built-in validator or of a
+// validator created with DescribedValidator. Returns false for other validators.
+//
+// Example:
+//
+//	d, _ := DescribeValidator(OneOfValidator("admin", "user"))
+//	d.Name()        // "one_of"
+//	d.Description() // "must be one of admin, user"
+func DescribeValidator(validator ContextValidator) (ValidatorDescriptor, bool) {
+	info, ok := describeValidator(validator)
+	if !ok {
+		return nil, false
+	}
+	return info, true
+}
+
+// plainValidatorNames names the built-in validators that take no arguments, by function pointer.
+var plainValidatorNames = map[uintptr]string{
+	funcPointer(IsStringValidator):    "is_string",
+	funcPointer(IsIntegerValidator):   "is_integer",
+	funcPointer(NumberValidator):      "number",
+	funcPointer(IsBooleanValidator):   "is_boolean",
+	funcPointer(IsListValidator):      "is_list",
+	funcPointer(IsMapValidator):       "is_map",
+	funcPointer(NullableValidator):    "nullable",
+	funcPointer(NonNullableValidator): "non_nullable",
+	funcPointer(EmailValidator):       "email",
+	funcPointer(UUIDValidator):        "uuid",
+	funcPointer(URLValidator):         "url",
+	funcPointer(IPv4Validator):        "ipv4",
+	funcPointer(IPv6Validator):        "ipv6",
+	funcPointer(XIDValidator):         "xid",
+	funcPointer(Base64Validator):      "base64",
+	funcPointer(JSONValidator):        "json",
+	funcPointer(LuhnValidator):        "luhn",
+	funcPointer(LowerCaseValidator):   "lower_case",
+	funcPointer(UpperCaseValidator):   "upper_case",
+	funcPointer(isTimeValidator):      "time",
+}
+
+// transformerNames names the built-in transformers by function pointer.
+var transformerNames = map[uintptr]string{
+	funcPointer(StringToIntTransformer):   "string_to_int",
+	funcPointer(StringToFloatTransformer): "string_to_float",
+	funcPointer(StringToBoolTransformer):  "string_to_bool",
+	funcPointer(RFC3339ToTimeTransformer): "rfc3339_to_time",
+	funcPointer(TrimSpaceTransformer):     "trim_space",
+	funcPointer(LowerCaseTransformer):     "lower_case",
+}
+
+// describeValidator returns the descriptor of a built-in or described validator.
+// Returns false for other validators, which are never called.
+func describeValidator(validator ContextValidator) (validatorInfo, bool) {
+	pointer := funcPointer(validator)
+	if name, found := plainValidatorNames[pointer]; found {
+		return builtinInfo(name), true
+	}
+	if pointer != describedValidatorPointer {
+		return validatorInfo{}, false
+	}
+	probe := &descriptorProbe{}
+	validator(context.Background(), probe)
+	return probe.info, probe.found
+}
+
+// describeRule returns the descriptor of a built-in object rule.
+// Returns false for custom rules, which are never called.
+func describeRule(rule ObjectRule) (validatorInfo, bool) {
+	if funcPointer(rule) != describedRulePointer {
+		return validatorInfo{}, false
+	}
+	probe := &descriptorProbe{}
+	rule(context.WithValue(context.Background(), descriptorProbeKey{}, probe), nil)
+	return probe.info, probe.found
+}
+
+// describeTransformer returns the name of a transformer, qualified by its package for custom ones.
+func describeTransformer(transformer Transformer) string {
+	if name, ok := transformerNames[funcPointer(transformer)]; ok {
+		return name
+	}
+	return funcName(transformer)
+}
+
+// funcPointer returns the code pointer of a function value.
+func funcPointer(fn any) uintptr {
+	return reflect.ValueOf(fn).Pointer()
+}
+
+// funcName returns the package-qualified name of a function value, such as "main.noProfanity".
+func funcName(fn any) string {
+	if f := runtime.FuncForPC(funcPointer(fn)); f != nil {
+		return f.Name()
+	}
+	return "unknown"
+}
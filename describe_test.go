@@ -0,0 +1,210 @@
+package govalidator_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"runtime"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		validator   govalidator.ContextValidator
+		wantName    string
+		wantParams  []govalidator.ValidatorParam
+		description string
+	}{
+		{name: "string", validator: govalidator.IsStringValidator, wantName: "is_string", description: "must be a string"},
+		{name: "integer", validator: govalidator.IsIntegerValidator, wantName: "is_integer", description: "must be an integer"},
+		{name: "number", validator: govalidator.NumberValidator, wantName: "number", description: "must be a number"},
+		{name: "boolean", validator: govalidator.IsBooleanValidator, wantName: "is_boolean", description: "must be a boolean"},
+		{name: "list", validator: govalidator.IsListValidator, wantName: "is_list", description: "must be a list"},
+		{name: "map", validator: govalidator.IsMapValidator, wantName: "is_map", description: "must be an object"},
+		{name: "nullable", validator: govalidator.NullableValidator, wantName: "nullable", description: "may be null"},
+		{name: "non nullable", validator: govalidator.NonNullableValidator, wantName: "non_nullable", description: "must not be null"},
+		{name: "email", validator: govalidator.EmailValidator, wantName: "email", description: "must be an email address"},
+		{name: "uuid", validator: govalidator.UUIDValidator, wantName: "uuid", description: "must be a UUID"},
+		{name: "url", validator: govalidator.URLValidator, wantName: "url", description: "must be a URL"},
+		{name: "ipv4", validator: govalidator.IPv4Validator, wantName: "ipv4", description: "must be an IPv4 address"},
+		{name: "ipv6", validator: govalidator.IPv6Validator, wantName: "ipv6", description: "must be an IPv6 address"},
+		{name: "xid", validator: govalidator.XIDValidator, wantName: "xid", description: "must be an XID"},
+		{name: "base64", validator: govalidator.Base64Validator, wantName: "base64", description: "must be base64 encoded"},
+		{name: "json", validator: govalidator.JSONValidator, wantName: "json", description: "must be a JSON document"},
+		{name: "luhn", validator: govalidator.LuhnValidator, wantName: "luhn", description: "must pass the Luhn checksum"},
+		{name: "lower case", validator: govalidator.LowerCaseValidator, wantName: "lower_case", description: "must be lower case"},
+		{name: "upper case", validator: govalidator.UpperCaseValidator, wantName: "upper_case", description: "must be upper case"},
+		{
+			name: "float", validator: govalidator.FloatValidator(2), wantName: "float",
+			wantParams:  []govalidator.ValidatorParam{{Name: "max_precision", Value: 2}},
+			description: "must be a number with at most 2 decimal places",
+		},
+		{
+			name: "min length", validator: govalidator.MinLengthValidator(3), wantName: "min_length",
+			wantParams:  []govalidator.ValidatorParam{{Name: "min", Value: 3}},
+			description: "must be at least 3 characters long",
+		},
+		{
+			name: "max length", validator: govalidator.MaxLengthValidator(20), wantName: "max_length",
+			wantParams:  []govalidator.ValidatorParam{{Name: "max", Value: 20}},
+			description: "must be at most 20 characters long",
+		},
+		{
+			name: "min size", validator: govalidator.MinSizeValidator(1, true), wantName: "min_size",
+			wantParams:  []govalidator.ValidatorParam{{Name: "min", Value: 1}, {Name: "blocks", Value: true}},
+			description: "must have at least 1 items",
+		},
+		{
+			name: "max size", validator: govalidator.MaxSizeValidator(5, false), wantName: "max_size",
+			wantParams:  []govalidator.ValidatorParam{{Name: "max", Value: 5}, {Name: "blocks", Value: false}},
+			description: "must have at most 5 items",
+		},
+		{
+			name: "min float", validator: govalidator.MinFloatValidator(0.5), wantName: "min_float",
+			wantParams:  []govalidator.ValidatorParam{{Name: "min", Value: 0.5}},
+			description: "must be at least 0.5",
+		},
+		{
+			name: "max float", validator: govalidator.MaxFloatValidator(120), wantName: "max_float",
+			wantParams:  []govalidator.ValidatorParam{{Name: "max", Value: 120.0}},
+			description: "must be at most 120",
+		},
+		{
+			name: "one of", validator: govalidator.OneOfValidator("admin", "user", "guest"), wantName: "one_of",
+			wantParams:  []govalidator.ValidatorParam{{Name: "options", Value: []any{"admin", "user", "guest"}}},
+			description: "must be one of admin, user, guest",
+		},
+		{
+			name: "regexp", validator: govalidator.RegexpValidator(*regexp.MustCompile(`^[a-z]+$`)), wantName: "regex",
+			wantParams:  []govalidator.ValidatorParam{{Name: "pattern", Value: "^[a-z]+$"}},
+			description: "must match ^[a-z]+$",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			descriptor, ok := govalidator.DescribeValidator(tt.validator)
+
+			require.True(t, ok)
+			assert.Equal(t, tt.wantName, descriptor.Name())
+			assert.Equal(t, tt.description, descriptor.Description())
+			if tt.wantParams == nil {
+				assert.Empty(t, descriptor.Params())
+			} else {
+				assert.Equal(t, tt.wantParams, descriptor.Params())
+			}
+		})
+	}
+
+	t.Run("descriptors compare by value", func(t *testing.T) {
+		a, _ := govalidator.DescribeValidator(govalidator.MinLengthValidator(3))
+		b, _ := govalidator.DescribeValidator(govalidator.MinLengthValidator(3))
+		c, _ := govalidator.DescribeValidator(govalidator.MinLengthValidator(4))
+
+		assert.Equal(t, a, b)
+		assert.NotEqual(t, a, c)
+	})
+
+	t.Run("plain functions have no descriptor", func(t *testing.T) {
+		descriptor, ok := govalidator.DescribeValidator(noProfanity)
+
+		assert.False(t, ok)
+		assert.Nil(t, descriptor)
+	})
+
+	t.Run("custom functions are never called", func(t *testing.T) {
+		calls := 0
+		counting := func(_ context.Context, _ any) (bool, []error) {
+			calls++
+			return false, nil
+		}
+
+		_, ok := govalidator.DescribeValidator(counting)
+		govalidator.NewSchema(counting).ToJSONSchema()
+
+		assert.False(t, ok)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("wrappers of built-ins have no descriptor", func(t *testing.T) {
+		minLength := govalidator.MinLengthValidator(3)
+		wrapper := func(ctx context.Context, value any) (bool, []error) {
+			return minLength(ctx, value)
+		}
+
+		_, ok := govalidator.DescribeValidator(wrapper)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("survive garbage collection", func(t *testing.T) {
+		for range 3 {
+			validator := govalidator.MaxLengthValidator(7)
+			runtime.GC()
+
+			descriptor, ok := govalidator.DescribeValidator(validator)
+
+			require.True(t, ok)
+			assert.Equal(t, []govalidator.ValidatorParam{{Name: "max", Value: 7}}, descriptor.Params())
+		}
+	})
+	t.Run("validators keep their own descriptors", func(t *testing.T) {
+		validators := make([]govalidator.ContextValidator, 100)
+		for i := range validators {
+			validators[i] = govalidator.MinLengthValidator(i)
+		}
+		runtime.GC()
+
+		for i, validator := range validators {
+			descriptor, ok := govalidator.DescribeValidator(validator)
+
+			require.True(t, ok)
+			assert.Equal(t, []govalidator.ValidatorParam{{Name: "min", Value: i}}, descriptor.Params())
+		}
+	})
+}
+
+func TestDescribedValidator(t *testing.T) {
+	errOdd := errors.New("odd")
+	even := func(_ context.Context, value any) (bool, []error) {
+		if n, ok := value.(int); ok && n%2 != 0 {
+			return false, []error{errOdd}
+		}
+		return false, nil
+	}
+	validator := govalidator.DescribedValidator(
+		govalidator.NewValidatorDescriptor("even", "must be even", govalidator.ValidatorParam{Name: "strict", Value: true}),
+		even,
+	)
+
+	t.Run("describes", func(t *testing.T) {
+		descriptor, ok := govalidator.DescribeValidator(validator)
+
+		require.True(t, ok)
+		assert.Equal(t, "even", descriptor.Name())
+		assert.Equal(t, "must be even", descriptor.Description())
+		assert.Equal(t, []govalidator.ValidatorParam{{Name: "strict", Value: true}}, descriptor.Params())
+	})
+
+	t.Run("validates like the wrapped validator", func(t *testing.T) {
+		_, errs := validator(context.Background(), 3)
+		assert.Equal(t, []error{errOdd}, errs)
+
+		_, errs = validator(context.Background(), 4)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("exports as an extension", func(t *testing.T) {
+		schema := govalidator.NewSchema(govalidator.IsIntegerValidator, validator).Required()
+
+		assert.JSONEq(t, `{
+			"type": "integer",
+			"x-validators": [{"name": "even", "description": "must be even", "params": {"strict": true}}]
+		}`, exportedJSON(t, schema))
+	})
+}
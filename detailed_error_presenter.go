@@ -3,6 +3,7 @@ package govalidator
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 // DetailedErrorPresenter creates a presenter that provides detailed, human-readable error messages.
@@ -15,8 +16,9 @@ import (
 //
 //nolint:cyclop // High complexity is acceptable for comprehensive error formatting
 func DetailedErrorPresenter() PresenterFunc {
-	return func(_ context.Context, _ []string, err error) string {
-		switch e := err.(type) { //nolint:errorlint // Errors are not wrapped in this codebase
+	var presenter PresenterFunc
+	presenter = func(ctx context.Context, path []string, err error) string {
+		switch e := unlocated(err).(type) { //nolint:errorlint // Errors are not wrapped in this codebase
 		case RequiredError:
 			return "this field is required"
 
@@ -71,11 +73,72 @@ func DetailedErrorPresenter() PresenterFunc {
 		case NotAValueError:
 			return "value is required"
 
+		case UnknownDiscriminatorError:
+			return fmt.Sprintf("'%s' must be one of %s (got %v)", e.Field, strings.Join(e.Allowed, ", "), e.Value)
+
+		case AnyOfError:
+			return "value must match at least one of the allowed schemas"
+
+		case ExactlyOneOfError:
+			return fmt.Sprintf("value must match exactly one of the allowed schemas (matched %d)", len(e.Matched))
+
+		case AllOfError:
+			return fmt.Sprintf("value must match all of the required schemas (%d rejected)", len(e.Branches))
+
+		case UnresolvedRefError:
+			return fmt.Sprintf("schema '%s' is not defined", e.Ref)
+
+		case MaxDepthExceededError:
+			return fmt.Sprintf("value is nested too deeply (at most %d levels allowed)", e.MaxDepth)
+
+		case InvalidKeyError:
+			return fmt.Sprintf("key '%s' is invalid: %s", e.Key, presenter(ctx, path, e.Err))
+
+		case TupleLengthError:
+			return detailedTupleLength(e)
+
+		case FieldMismatchError:
+			return fmt.Sprintf("'%s' must match '%s'", e.Field, e.Other)
+
+		case FieldComparisonError:
+			return fmt.Sprintf("'%s' must be %s '%s'", e.Field, e.Operator, e.Other)
+
+		case ConditionalRequiredError:
+			return fmt.Sprintf("field '%s' is required when '%s' %s", e.Field, e.Trigger, e.Condition)
+
+		case MutuallyExclusiveError:
+			return fmt.Sprintf("field '%s' cannot be used together with '%s'", e.Field, e.Conflict)
+
+		case MissingFieldError:
+			return fmt.Sprintf("field '%s' must be present", e.Field)
+
+		case NullNotAllowedError:
+			return "value must not be null"
+
+		case CoercionError:
+			return fmt.Sprintf("value must be convertible to %s (got %q)", e.Target, fmt.Sprint(e.Value))
+
+		case DecodeTypeError:
+			return fmt.Sprintf("value of type %s cannot be stored as %s", e.Value, e.Type)
+
 		default:
 			// fallback to default error message
 			return err.Error()
 		}
 	}
+	return presenter
+}
+
+// detailedTupleLength formats a TupleLengthError in the style of MinSizeError and MaxSizeError.
+func detailedTupleLength(e TupleLengthError) string {
+	switch {
+	case e.MinItems == e.MaxItems:
+		return fmt.Sprintf("list must contain exactly %d item(s) (got %d)", e.MinItems, e.ActualItems)
+	case e.ActualItems < e.MinItems:
+		return fmt.Sprintf("list must contain at least %d item(s) (got %d)", e.MinItems, e.ActualItems)
+	default:
+		return fmt.Sprintf("list must contain at most %d item(s) (got %d)", e.MaxItems, e.ActualItems)
+	}
 }
 
 // VerboseErrorPresenter creates a presenter that provides very detailed technical error messages.
@@ -86,7 +149,7 @@ func DetailedErrorPresenter() PresenterFunc {
 //   - "FloatPrecisionError: expected precision 2, actual precision 4"
 func VerboseErrorPresenter() PresenterFunc {
 	return func(_ context.Context, _ []string, err error) string {
-		switch e := err.(type) { //nolint:errorlint // Errors are not wrapped in this codebase
+		switch e := unlocated(err).(type) { //nolint:errorlint // Errors are not wrapped in this codebase
 		case MinSizeError:
 			return fmt.Sprintf("MinSizeError: expected minimum size %d, actual size %d", e.MinSize, e.ActualSize)
 
@@ -114,9 +177,24 @@ func VerboseErrorPresenter() PresenterFunc {
 		case UnexpectedFieldError:
 			return fmt.Sprintf("UnexpectedFieldError: field '%s' is not allowed", e.Field)
 
+		case UnknownDiscriminatorError:
+			return fmt.Sprintf("UnknownDiscriminatorError: field '%s' has value %v, allowed values are %v", e.Field, e.Value, e.Allowed)
+
+		case MissingFieldError:
+			return fmt.Sprintf("MissingFieldError: field '%s' is absent", e.Field)
+
+		case NullNotAllowedError:
+			return "NullNotAllowedError: explicit null is not allowed"
+
+		case CoercionError:
+			return fmt.Sprintf("CoercionError: cannot convert %v (%T) to %s", e.Value, e.Value, e.Target)
+
+		case DecodeTypeError:
+			return fmt.Sprintf("DecodeTypeError: JSON %s does not fit Go type %s", e.Value, e.Type)
+
 		default:
 			// For other errors, return their type and message
-			return fmt.Sprintf("%T: %s", err, err.Error())
+			return fmt.Sprintf("%T: %s", unlocated(err), err.Error())
 		}
 	}
 }
@@ -0,0 +1,110 @@
+package govalidator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// discriminator selects one of several object schemas based on the value of a tag field.
+type discriminator struct {
+	field   string
+	mapping map[string]*Schema
+}
+
+// UnknownDiscriminatorError is returned when a discriminator tag does not name a known branch.
+type UnknownDiscriminatorError struct {
+	Field   string
+	Value   any
+	Allowed []string
+}
+
+// Error returns the error message.
+func (e UnknownDiscriminatorError) Error() string {
+	return fmt.Sprintf("unknown %s %v, expected one of %v", e.Field, e.Value, e.Allowed)
+}
+
+// Discriminator turns this schema into a discriminated union.
+// The value of the tag field selects a branch from the mapping, which is applied
+// to the object in addition to the schema itself: its validators, combinators,
+// conditionals, fields, key validators, rules, pattern fields and value schema.
+// Transformers and defaults of the branch itself are not applied, since the
+// object is already converted when the branch is selected. Only errors from the
+// selected branch are reported.
+// The tag field is always required; an unknown tag is reported at its path.
+// Extra fields are judged against the combined field set using this schema's Extra mode.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	schema := Object(map[string]*Schema{
+//	    "id": NewSchema(IsStringValidator).Required(),
+//	}).Discriminator("type", map[string]*Schema{
+//	    "push": Object(map[string]*Schema{
+//	        "ref": NewSchema(IsStringValidator).Required(),
+//	    }),
+//	    "issue": Object(map[string]*Schema{
+//	        "number": NewSchema(IsIntegerValidator).Required(),
+//	    }),
+//	})
+func (s *Schema) Discriminator(field string, mapping map[string]*Schema) *Schema {
+	s.discriminator = &discriminator{
+		field:   field,
+		mapping: mapping,
+	}
+	return s
+}
+
+// allowed returns the known tag values in sorted order.
+func (d *discriminator) allowed() []string {
+	tags := make([]string, 0, len(d.mapping))
+	for tag := range d.mapping {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// validateDiscriminator selects the branch named by the tag field and validates the object against it.
+// Returns the selected branches, including those of nested discriminators, and false
+// if no branch could be selected or its validators blocked.
+func (sv *SchemaValidator) validateDiscriminator(
+	valCtx *ValidationContext,
+	currentMap map[string]any,
+	d *discriminator,
+	declared map[string]bool,
+	out map[string]any,
+) ([]objectSchema, bool) {
+	declared[d.field] = true
+	tagCtx := sv.pushPath(valCtx, d.field)
+
+	tag, exists := currentMap[d.field]
+	if !exists || tag == nil {
+		tagCtx.errorCollector.Collect(tagCtx.path, RequiredError{})
+		return nil, false
+	}
+
+	name, isString := tag.(string)
+	branch, known := d.mapping[name]
+	if !isString || !known || branch == nil {
+		tagCtx.errorCollector.Collect(tagCtx.path, UnknownDiscriminatorError{
+			Field:   d.field,
+			Value:   tag,
+			Allowed: d.allowed(),
+		})
+		return nil, false
+	}
+
+	// The branch is applied like the schema of the object, except for transformers and defaults
+	branchCtx := sv.enterScope(valCtx, branch)
+	if !sv.runValidators(branchCtx, currentMap, branch) {
+		return nil, false
+	}
+	normalized := sv.validateCombinators(branchCtx, currentMap, branch)
+	if conditional, chosen := sv.validateIfThenElse(branchCtx, currentMap, branch); chosen && valCtx.normalize {
+		normalized = append(normalized, conditional)
+	}
+
+	nested, resolved := sv.validateObjectFields(branchCtx, currentMap, branch, declared, out)
+	selected := objectSchema{schema: branch, valCtx: branchCtx, normalized: normalized}
+	return append([]objectSchema{selected}, nested...), resolved
+}
@@ -0,0 +1,236 @@
+package govalidator_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func eventSchema() *govalidator.Schema {
+	return govalidator.Object(map[string]*govalidator.Schema{
+		"id": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+	}).Discriminator("type", map[string]*govalidator.Schema{
+		"push": govalidator.Object(map[string]*govalidator.Schema{
+			"ref": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		}),
+		"issue": govalidator.Object(map[string]*govalidator.Schema{
+			"number": govalidator.NewSchema(govalidator.IsIntegerValidator).Required(),
+		}),
+	})
+}
+
+func TestSchema_Discriminator(t *testing.T) {
+	t.Run("validates selected branch", func(t *testing.T) {
+		valid, errs := eventSchema().Validate(context.Background(), map[string]any{
+			"id":   "evt_1",
+			"type": "push",
+			"ref":  "refs/heads/main",
+		})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("reports only errors from selected branch", func(t *testing.T) {
+		valid, errs := eventSchema().Validate(context.Background(), map[string]any{
+			"id":   "evt_1",
+			"type": "issue",
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.number": {"required"}}, errs)
+	})
+
+	t.Run("still validates shared fields", func(t *testing.T) {
+		valid, errs := eventSchema().Validate(context.Background(), map[string]any{
+			"id":   1,
+			"type": "push",
+			"ref":  "main",
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.id": {"not a string"}}, errs)
+	})
+
+	t.Run("unknown tag is reported at tag path", func(t *testing.T) {
+		valid, errs := eventSchema().Validate(context.Background(), map[string]any{
+			"id":   "evt_1",
+			"type": "fork",
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$.type": {"unknown type fork, expected one of [issue push]"},
+		}, errs)
+	})
+
+	t.Run("non-string tag is unknown", func(t *testing.T) {
+		valid, errs := eventSchema().Validate(context.Background(), map[string]any{
+			"id":   "evt_1",
+			"type": 5.0,
+		})
+
+		assert.False(t, valid)
+		assert.Contains(t, errs, "$.type")
+	})
+
+	t.Run("missing tag is required", func(t *testing.T) {
+		valid, errs := eventSchema().Validate(context.Background(), map[string]any{
+			"id": "evt_1",
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.type": {"required"}}, errs)
+	})
+
+	t.Run("extra fields are judged against combined field set", func(t *testing.T) {
+		schema := eventSchema().WithExtra(govalidator.ExtraForbid)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"id":     "evt_1",
+			"type":   "push",
+			"ref":    "main",
+			"number": 3,
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"unexpected field number"}}, errs)
+	})
+
+	t.Run("extra fields are not reported when tag is unknown", func(t *testing.T) {
+		schema := eventSchema().WithExtra(govalidator.ExtraForbid)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"id":   "evt_1",
+			"type": "fork",
+			"ref":  "main",
+		})
+
+		assert.False(t, valid)
+		assert.Len(t, errs, 1)
+		assert.Contains(t, errs, "$.type")
+	})
+
+	t.Run("runs branch validators on the object", func(t *testing.T) {
+		schema := govalidator.NewSchema().Discriminator("kind", map[string]*govalidator.Schema{
+			"sized": govalidator.NewSchema(govalidator.MinSizeValidator(1, true)),
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"kind": "sized"})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"not a list"}}, errs)
+	})
+
+	t.Run("applies branch rules", func(t *testing.T) {
+		schema := govalidator.NewSchema().Discriminator("kind", map[string]*govalidator.Schema{
+			"signup": govalidator.Object(map[string]*govalidator.Schema{
+				"password": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+				"confirm":  govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+			}).WithRules(govalidator.EqualFieldsRule("confirm", "password")),
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"kind":     "signup",
+			"password": "secret",
+			"confirm":  "secrets",
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.confirm": {"must equal password"}}, errs)
+	})
+
+	t.Run("applies branch keys, patterns and values", func(t *testing.T) {
+		schema := govalidator.NewSchema().Discriminator("kind", map[string]*govalidator.Schema{
+			"labels": govalidator.NewSchema().
+				Keys(govalidator.LowerCaseValidator).
+				PatternField(regexp.MustCompile(`^x-`), govalidator.NewSchema(govalidator.IsBooleanValidator)).
+				Values(govalidator.NewSchema(govalidator.IsStringValidator)),
+		}).WithExtra(govalidator.ExtraForbid)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"kind":  "labels",
+			"env":   1,
+			"x-new": "yes",
+			"Team":  "core",
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$.env":   {"not a string"},
+			"$.x-new": {"not a boolean"},
+			"$.Team":  {`invalid key "Team": "Team" is not lower cased`},
+		}, errs)
+	})
+
+	t.Run("applies branch conditionals and combinators", func(t *testing.T) {
+		schema := govalidator.NewSchema().Discriminator("kind", map[string]*govalidator.Schema{
+			"card": govalidator.Object(map[string]*govalidator.Schema{
+				"country": govalidator.NewSchema(govalidator.IsStringValidator),
+			}).
+				If(govalidator.Object(map[string]*govalidator.Schema{
+					"country": govalidator.NewSchema(govalidator.OneOfValidator("US")).Required(),
+				})).
+				Then(govalidator.Object(map[string]*govalidator.Schema{
+					"zip": govalidator.NewSchema().Required(),
+				})),
+			"any": govalidator.AnyOf(
+				govalidator.Object(map[string]*govalidator.Schema{"a": govalidator.NewSchema().Required()}),
+				govalidator.Object(map[string]*govalidator.Schema{"b": govalidator.NewSchema().Required()}),
+			),
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"kind": "card", "country": "US"})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.zip": {"required"}}, errs)
+
+		valid, errs = schema.Validate(context.Background(), map[string]any{"kind": "any"})
+
+		assert.False(t, valid)
+		assert.Len(t, errs["$"], 1)
+	})
+
+	t.Run("supports nested discriminators", func(t *testing.T) {
+		schema := govalidator.NewSchema().Discriminator("kind", map[string]*govalidator.Schema{
+			"shape": govalidator.NewSchema().Discriminator("shape", map[string]*govalidator.Schema{
+				"circle": govalidator.Object(map[string]*govalidator.Schema{
+					"radius": govalidator.NewSchema(govalidator.NumberValidator).Required(),
+				}),
+			}),
+		}).WithExtra(govalidator.ExtraForbid)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"kind":   "shape",
+			"shape":  "circle",
+			"radius": 2.5,
+		})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("rejects non-object values", func(t *testing.T) {
+		valid, errs := eventSchema().Validate(context.Background(), "push")
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"not a map"}}, errs)
+	})
+}
+
+func TestUnknownDiscriminatorError(t *testing.T) {
+	err := govalidator.UnknownDiscriminatorError{Field: "type", Value: "x", Allowed: []string{"a", "b"}}
+
+	assert.EqualError(t, err, "unknown type x, expected one of [a b]")
+	assert.Equal(t, "'type' must be one of a, b (got x)",
+		govalidator.DetailedErrorPresenter()(context.Background(), []string{"$"}, err))
+	assert.JSONEq(t,
+		`{"path":"$.type","message":"unknown type x, expected one of [a b]","type":"UnknownDiscriminatorError","field":"type","value":"x","allowed":["a","b"]}`,
+		govalidator.JSONDetailedPresenter(".")(context.Background(), []string{"$", "type"}, err))
+	assert.Contains(t,
+		govalidator.VerboseErrorPresenter()(context.Background(), []string{"$"}, err),
+		"UnknownDiscriminatorError")
+}
@@ -82,13 +82,13 @@ var (
 			Required().
 			WithValidators(govalidator.IsBooleanValidator),
 		govalidator.NewField("certFile").
-			Optional().
+			RequiredIf("enabled", true).
 			WithValidators(
 				govalidator.IsStringValidator,
 				govalidator.MinLengthValidator(1),
 			),
 		govalidator.NewField("keyFile").
-			Optional().
+			RequiredIf("enabled", true).
 			WithValidators(
 				govalidator.IsStringValidator,
 				govalidator.MinLengthValidator(1),
@@ -332,9 +332,7 @@ func createSampleConfig(filename string, valid bool) error {
 				"readTimeout":  30,
 				"writeTimeout": 30,
 				"tls": map[string]any{
-					"enabled":  false,
-					"certFile": "",
-					"keyFile":  "",
+					"enabled": false,
 				},
 			},
 			"database": map[string]any{
@@ -3,28 +3,16 @@ package main
 import (
 	"context"
 	"encoding/csv"
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/gstachniukrsk/govalidator"
 )
 
-// Employee represents an employee record from CSV
-type Employee struct {
-	ID         string
-	FirstName  string
-	LastName   string
-	Email      string
-	Department string
-	Salary     string
-	Status     string
-}
-
 // Define validation schema for employee records
 var (
 	emailPattern = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
@@ -64,7 +52,7 @@ var (
 			),
 		govalidator.NewField("salary").
 			Required().
-			WithValidators(
+			WithValidators( // Converted to a number by the CSV validator
 				govalidator.NumberValidator,
 				govalidator.MinFloatValidator(0),
 				govalidator.MaxFloatValidator(1000000),
@@ -78,92 +66,32 @@ var (
 	).WithExtra(govalidator.ExtraForbid)
 )
 
-// convertEmployeeToMap converts an employee record to a map for validation
-func convertEmployeeToMap(emp Employee) map[string]any {
-	data := map[string]any{
-		"id":         emp.ID,
-		"firstName":  emp.FirstName,
-		"lastName":   emp.LastName,
-		"email":      emp.Email,
-		"department": emp.Department,
-		"status":     emp.Status,
-	}
-
-	// Convert salary string to float for validation
-	if salary, err := strconv.ParseFloat(emp.Salary, 64); err == nil {
-		data["salary"] = salary
-	} else {
-		data["salary"] = emp.Salary // Keep as string so validator can catch it
-	}
-
-	return data
-}
-
-// ValidationResult holds the validation result for a single row
-type ValidationResult struct {
-	RowNumber int
-	Employee  Employee
-	Valid     bool
-	Errors    []string
-}
-
-// validateCSV reads and validates a CSV file
-func validateCSV(filename string) ([]ValidationResult, error) {
+// validateCSV validates a CSV file row by row, writing invalid rows to rejectedFilename
+func validateCSV(filename, rejectedFilename string) ([]govalidator.CSVRowResult, error) {
 	file, err := os.Open(filename)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
 	defer file.Close()
 
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
+	rejected, err := os.Create(rejectedFilename)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read CSV: %w", err)
+		return nil, fmt.Errorf("failed to create rejected rows file: %w", err)
 	}
+	defer rejected.Close()
 
-	if len(records) < 2 {
-		return nil, fmt.Errorf("CSV file must have a header row and at least one data row")
-	}
-
-	// Skip header row
-	results := make([]ValidationResult, 0, len(records)-1)
-
-	for i, record := range records[1:] {
-		if len(record) != 7 {
-			results = append(results, ValidationResult{
-				RowNumber: i + 2, // +2 because we skip header and arrays are 0-indexed
-				Valid:     false,
-				Errors:    []string{"Row must have exactly 7 columns"},
-			})
-			continue
-		}
-
-		emp := Employee{
-			ID:         record[0],
-			FirstName:  record[1],
-			LastName:   record[2],
-			Email:      record[3],
-			Department: record[4],
-			Salary:     record[5],
-			Status:     record[6],
-		}
+	// Headers match field names regardless of case; "Dept" is an alias
+	validator := govalidator.NewCSVValidator(employeeSchema).
+		WithAlias("department", "Dept").
+		WithRejected(rejected, "errors")
 
-		// Convert to map for validation
-		data := convertEmployeeToMap(emp)
-
-		// Validate
-		valid, errs := employeeSchema.ValidateFlat(
-			context.Background(),
-			data,
-			govalidator.CombinedPresenter(".", ": "),
-		)
-
-		results = append(results, ValidationResult{
-			RowNumber: i + 2,
-			Employee:  emp,
-			Valid:     valid,
-			Errors:    errs,
-		})
+	var results []govalidator.CSVRowResult
+	err = validator.Validate(context.Background(), file, func(result govalidator.CSVRowResult) error {
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate CSV: %w", err)
 	}
 
 	return results, nil
@@ -181,7 +109,7 @@ func createSampleCSV(filename string) error {
 	defer writer.Flush()
 
 	// Write header
-	header := []string{"ID", "FirstName", "LastName", "Email", "Department", "Salary", "Status"}
+	header := []string{"ID", "FirstName", "LastName", "Email", "Dept", "Salary", "Status"}
 	if err := writer.Write(header); err != nil {
 		return err
 	}
@@ -208,7 +136,7 @@ func createSampleCSV(filename string) error {
 }
 
 // printResults prints validation results in a readable format
-func printResults(results []ValidationResult) {
+func printResults(results []govalidator.CSVRowResult) {
 	validCount := 0
 	invalidCount := 0
 
@@ -219,19 +147,15 @@ func printResults(results []ValidationResult) {
 	for _, result := range results {
 		if result.Valid {
 			validCount++
-			fmt.Printf("✓ Row %d: VALID - %s %s (%s)\n",
-				result.RowNumber,
-				result.Employee.FirstName,
-				result.Employee.LastName,
-				result.Employee.Email,
+			fmt.Printf("✓ Row %d: VALID - %v %v (%v)\n",
+				result.Row,
+				result.Value["firstName"],
+				result.Value["lastName"],
+				result.Value["email"],
 			)
 		} else {
 			invalidCount++
-			fmt.Printf("✗ Row %d: INVALID - %s %s\n",
-				result.RowNumber,
-				result.Employee.FirstName,
-				result.Employee.LastName,
-			)
+			fmt.Printf("✗ Row %d: INVALID\n", result.Row)
 			for _, err := range result.Errors {
 				fmt.Printf("    - %s\n", err)
 			}
@@ -244,39 +168,10 @@ func printResults(results []ValidationResult) {
 	fmt.Println(strings.Repeat("=", 80) + "\n")
 }
 
-// exportInvalidRowsJSON exports invalid rows to a JSON file for further processing
-func exportInvalidRowsJSON(results []ValidationResult, filename string) error {
-	invalidRows := make([]ValidationResult, 0)
-	for _, result := range results {
-		if !result.Valid {
-			invalidRows = append(invalidRows, result)
-		}
-	}
-
-	if len(invalidRows) == 0 {
-		fmt.Println("No invalid rows to export")
-		return nil
-	}
-
-	file, err := os.Create(filename)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	encoder := json.NewEncoder(file)
-	encoder.SetIndent("", "  ")
-	if err := encoder.Encode(invalidRows); err != nil {
-		return err
-	}
-
-	fmt.Printf("Exported %d invalid rows to %s\n", len(invalidRows), filename)
-	return nil
-}
-
 func main() {
-	csvFile := "employees.csv"
-	jsonFile := "invalid_rows.json"
+	// Write the sample and the rejected rows outside the source tree
+	csvFile := filepath.Join(os.TempDir(), "employees.csv")
+	rejectedFile := filepath.Join(os.TempDir(), "rejected_rows.csv")
 
 	// Create sample CSV file
 	fmt.Println("Creating sample CSV file...")
@@ -285,9 +180,9 @@ func main() {
 	}
 	fmt.Printf("Created sample file: %s\n", csvFile)
 
-	// Validate CSV
+	// Validate CSV, writing invalid rows with their errors to a separate file
 	fmt.Println("\nValidating CSV file...")
-	results, err := validateCSV(csvFile)
+	results, err := validateCSV(csvFile, rejectedFile)
 	if err != nil {
 		log.Fatalf("Validation failed: %v", err)
 	}
@@ -295,12 +190,7 @@ func main() {
 	// Print results
 	printResults(results)
 
-	// Export invalid rows to JSON
-	if err := exportInvalidRowsJSON(results, jsonFile); err != nil {
-		log.Fatalf("Failed to export invalid rows: %v", err)
-	}
-
-	fmt.Println("\nValidation complete!")
+	fmt.Println("Validation complete!")
 	fmt.Printf("Check '%s' for the sample data\n", csvFile)
-	fmt.Printf("Check '%s' for invalid rows details\n", jsonFile)
+	fmt.Printf("Check '%s' for the rejected rows and their errors\n", rejectedFile)
 }
@@ -109,8 +109,67 @@ var (
 				),
 			),
 	).WithExtra(govalidator.ExtraForbid)
+
+	// Error response schemas, used to document the API
+	errorSchema = govalidator.Object(map[string]*govalidator.Schema{
+		"error": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+	}).Required()
+
+	validationErrorSchema = govalidator.Object(map[string]*govalidator.Schema{
+		"error":  govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		"errors": govalidator.Array(govalidator.NewSchema(govalidator.IsStringValidator).Required()).Required(),
+	}).Required()
+
+	// Created response schemas: the handlers echo the validated request under "data"
+	userCreatedSchema    = createdSchema(userSchema)
+	productCreatedSchema = createdSchema(productSchema)
 )
 
+// createdSchema describes a 201 response carrying a message and the created resource
+func createdSchema(data *govalidator.Schema) *govalidator.Schema {
+	return govalidator.Object(map[string]*govalidator.Schema{
+		"message": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		"data":    data,
+	}).Required()
+}
+
+// buildOpenAPI describes the endpoints with the same schemas that validate their requests
+func buildOpenAPI() (map[string]any, error) {
+	responses := func(created string) map[int]string {
+		return map[int]string{
+			http.StatusCreated:             created,
+			http.StatusBadRequest:          "Error",
+			http.StatusUnprocessableEntity: "ValidationError",
+		}
+	}
+
+	return govalidator.OpenAPIDocument(
+		govalidator.OpenAPIInfo{Title: "Example API", Version: "1.0.0"},
+		map[string]*govalidator.Schema{
+			"UserRegistration": userSchema,
+			"ProductCreation":  productSchema,
+			"UserCreated":      userCreatedSchema,
+			"ProductCreated":   productCreatedSchema,
+			"Error":            errorSchema,
+			"ValidationError":  validationErrorSchema,
+		},
+		govalidator.OpenAPIOperation{
+			Method:      http.MethodPost,
+			Path:        "/api/users/register",
+			Summary:     "Register a user",
+			RequestBody: "UserRegistration",
+			Responses:   responses("UserCreated"),
+		},
+		govalidator.OpenAPIOperation{
+			Method:      http.MethodPost,
+			Path:        "/api/products",
+			Summary:     "Create a product",
+			RequestBody: "ProductCreation",
+			Responses:   responses("ProductCreated"),
+		},
+	)
+}
+
 // validateRequest validates HTTP request body against a schema
 func validateRequest(r *http.Request, schema *govalidator.Schema) (any, []string, error) {
 	var data any
@@ -203,9 +262,28 @@ func handleProductCreation(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// handleOpenAPI serves the OpenAPI document
+func handleOpenAPI(doc map[string]any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeJSONResponse(w, http.StatusMethodNotAllowed, map[string]string{
+				"error": "Method not allowed",
+			})
+			return
+		}
+		writeJSONResponse(w, http.StatusOK, doc)
+	}
+}
+
 func main() {
+	openAPI, err := buildOpenAPI()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	http.HandleFunc("/api/users/register", handleUserRegistration)
 	http.HandleFunc("/api/products", handleProductCreation)
+	http.HandleFunc("/openapi.json", handleOpenAPI(openAPI))
 
 	fmt.Println("Server starting on :8080")
 	fmt.Println("\nTry these curl commands:")
@@ -229,6 +307,9 @@ func main() {
   -H "Content-Type: application/json" \
   -d '{"name":"Laptop","price":-100,"categories":[]}'`)
 
+	fmt.Println("\n5. OpenAPI document generated from the schemas:")
+	fmt.Println(`curl http://localhost:8080/openapi.json`)
+
 	fmt.Println()
 
 	log.Fatal(http.ListenAndServe(":8080", nil))
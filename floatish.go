@@ -10,7 +10,7 @@ import (
 //
 //	if float checks against maximal precision.
 func FloatValidator(maxPrecision int) ContextValidator {
-	return func(_ context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(_ context.Context, value any) (twigBlock bool, errs []error) {
 		f, ok := value.(float64)
 		_, ok2 := value.(int)
 
@@ -52,5 +52,5 @@ func FloatValidator(maxPrecision int) ContextValidator {
 		}
 
 		return
-	}
+	}, builtinInfo("float", maxPrecision))
 }
@@ -0,0 +1,58 @@
+package govalidator
+
+// If sets a condition schema. The value is validated against the condition
+// without reporting errors; if it matches, the Then schema is applied,
+// otherwise the Else schema. Errors of the chosen branch are reported normally,
+// and Normalize keeps the defaults and conversions of the chosen branch.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	payment := Object(map[string]*Schema{
+//	    "method": NewSchema(IsStringValidator, OneOfValidator("card", "iban")).Required(),
+//	}).
+//	    If(Object(map[string]*Schema{"method": NewSchema(OneOfValidator("card"))})).
+//	    Then(Object(map[string]*Schema{
+//	        "card": Object(map[string]*Schema{
+//	            "number": NewSchema(IsStringValidator, LuhnValidator).Required(),
+//	        }).Required(),
+//	    })).
+//	    Else(Object(map[string]*Schema{
+//	        "iban": NewSchema(IsStringValidator).Required(),
+//	    }))
+func (s *Schema) If(condition *Schema) *Schema {
+	s.ifSchema = condition
+	return s
+}
+
+// Then sets the schema applied when the If condition matches.
+// Returns the schema for method chaining.
+func (s *Schema) Then(schema *Schema) *Schema {
+	s.thenSchema = schema
+	return s
+}
+
+// Else sets the schema applied when the If condition does not match.
+// Returns the schema for method chaining.
+func (s *Schema) Else(schema *Schema) *Schema {
+	s.elseSchema = schema
+	return s
+}
+
+// validateIfThenElse evaluates the If condition silently and validates the value against the chosen branch.
+// Returns the value normalized by the chosen branch, and false if there is none.
+func (sv *SchemaValidator) validateIfThenElse(valCtx *ValidationContext, value any, schema *Schema) (any, bool) {
+	if schema.ifSchema == nil {
+		return nil, false
+	}
+
+	branch := schema.elseSchema
+	if errs, _ := sv.validateSilently(valCtx, value, schema.ifSchema); len(errs) == 0 {
+		branch = schema.thenSchema
+	}
+
+	if branch == nil {
+		return nil, false
+	}
+	return sv.validateValue(valCtx, value, branch), true
+}
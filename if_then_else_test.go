@@ -0,0 +1,89 @@
+package govalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func paymentSchema() *govalidator.Schema {
+	return govalidator.Object(map[string]*govalidator.Schema{
+		"method": govalidator.NewSchema(govalidator.IsStringValidator, govalidator.OneOfValidator("card", "iban")).Required(),
+	}).
+		If(govalidator.Object(map[string]*govalidator.Schema{
+			"method": govalidator.NewSchema(govalidator.OneOfValidator("card")),
+		})).
+		Then(govalidator.Object(map[string]*govalidator.Schema{
+			"card": govalidator.Object(map[string]*govalidator.Schema{
+				"number": govalidator.NewSchema(govalidator.IsStringValidator, govalidator.LuhnValidator).Required(),
+			}).Required(),
+		})).
+		Else(govalidator.Object(map[string]*govalidator.Schema{
+			"iban": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		}))
+}
+
+func TestSchema_IfThenElse(t *testing.T) {
+	t.Run("applies then branch when condition matches", func(t *testing.T) {
+		valid, errs := paymentSchema().Validate(context.Background(), map[string]any{
+			"method": "card",
+			"card":   map[string]any{"number": "4111111111111112"},
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.card.number": {"invalid Luhn checksum"}}, errs)
+	})
+
+	t.Run("accepts valid then branch", func(t *testing.T) {
+		valid, errs := paymentSchema().Validate(context.Background(), map[string]any{
+			"method": "card",
+			"card":   map[string]any{"number": "4111111111111111"},
+		})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("applies else branch when condition does not match", func(t *testing.T) {
+		valid, errs := paymentSchema().Validate(context.Background(), map[string]any{
+			"method": "iban",
+			"card":   map[string]any{"number": "bogus"},
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.iban": {"required"}}, errs)
+	})
+
+	t.Run("condition errors are never reported", func(t *testing.T) {
+		schema := govalidator.NewSchema().If(govalidator.NewSchema(govalidator.IsStringValidator))
+
+		valid, errs := schema.Validate(context.Background(), 5)
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("missing branch is skipped", func(t *testing.T) {
+		schema := govalidator.NewSchema().
+			If(govalidator.NewSchema(govalidator.IsStringValidator)).
+			Then(govalidator.NewSchema(govalidator.MinLengthValidator(3)))
+
+		valid, _ := schema.Validate(context.Background(), 5)
+		assert.True(t, valid)
+
+		valid, errs := schema.Validate(context.Background(), "ab")
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"expected at least 3 characters"}}, errs)
+	})
+
+	t.Run("is delegated by ToDefinition", func(t *testing.T) {
+		_, errs := govalidator.NewBasicValidator(
+			govalidator.PathPresenter("."),
+			govalidator.SimpleErrorPresenter(),
+		).Validate(context.Background(), map[string]any{"method": "iban"}, paymentSchema().ToDefinition())
+
+		assert.Equal(t, map[string][]string{"$": {"iban: required"}}, errs)
+	})
+}
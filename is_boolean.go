@@ -2,6 +2,7 @@ package govalidator
 
 import (
 	"context"
+	"reflect"
 )
 
 // IsBooleanValidator is a validator that checks if the value is a boolean.
@@ -9,6 +10,10 @@ func IsBooleanValidator(_ context.Context, value any) (twigBlock bool, errs []er
 	switch value.(type) {
 	case bool, *bool:
 		return
+	default:
+		if reflect.ValueOf(value).Kind() == reflect.Bool {
+			return
+		}
 	}
 	twigBlock = true
 	errs = append(errs, NotABooleanError{})
@@ -17,6 +17,10 @@ func IsIntegerValidator(_ context.Context, value any) (twigBlock bool, errs []er
 		}
 	case int:
 		return
+	default:
+		if isNativeInteger(value) {
+			return
+		}
 	}
 
 	return true, []error{NotAnIntegerError{}}
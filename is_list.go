@@ -11,6 +11,10 @@ func IsListValidator(_ context.Context, value any) (twigBlock bool, errs []error
 		if v != nil {
 			return
 		}
+	default:
+		if _, ok := nativeListLen(value); ok {
+			return
+		}
 	}
 
 	twigBlock = true
@@ -14,6 +14,10 @@ func IsMapValidator(_ context.Context, value any) (twigBlock bool, errs []error)
 		if v != nil {
 			return
 		}
+	default:
+		if isNativeMap(value) {
+			return
+		}
 	}
 
 	twigBlock = true
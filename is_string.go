@@ -2,6 +2,7 @@ package govalidator
 
 import (
 	"context"
+	"reflect"
 )
 
 // IsStringValidator is a validator that checks if the value is a string or pointer of a string.
@@ -13,6 +14,10 @@ func IsStringValidator(_ context.Context, value any) (twigBlock bool, errs []err
 		if v != nil {
 			return
 		}
+	default:
+		if reflect.ValueOf(value).Kind() == reflect.String {
+			return
+		}
 	}
 
 	twigBlock = true
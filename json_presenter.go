@@ -6,7 +6,8 @@ import (
 )
 
 // JSONPresenter creates a presenter that formats errors as JSON strings.
-// Each error is converted to a JSON object with "path" and "message" fields.
+// Each error is converted to a JSON object with "path" and "message" fields,
+// and "line", "column" and "offset" fields for errors located by ValidateJSON.
 //
 // Example output: {"path":"$.user.age","message":"not an integer"}
 func JSONPresenter(pathGlue string) PresenterFunc {
@@ -15,10 +16,11 @@ func JSONPresenter(pathGlue string) PresenterFunc {
 	return func(ctx context.Context, path []string, err error) string {
 		pathStr := pathPresenter(ctx, path, err)
 
-		errorData := map[string]string{
+		errorData := map[string]any{
 			"path":    pathStr,
 			"message": err.Error(),
 		}
+		addSourcePosition(errorData, err)
 
 		jsonBytes, jsonErr := json.Marshal(errorData)
 		if jsonErr != nil {
@@ -33,6 +35,8 @@ func JSONPresenter(pathGlue string) PresenterFunc {
 // JSONDetailedPresenter creates a presenter that formats errors as detailed JSON strings.
 // It attempts to extract structured information from typed errors when available.
 //
+// Errors located by ValidateJSON also get "line", "column" and "offset" fields.
+//
 // Example output: {"path":"$.age","message":"not an integer","type":"NotAnIntegerError"}
 func JSONDetailedPresenter(pathGlue string) PresenterFunc {
 	pathPresenter := PathPresenter(pathGlue)
@@ -45,9 +49,10 @@ func JSONDetailedPresenter(pathGlue string) PresenterFunc {
 			"message": err.Error(),
 			"type":    getErrorType(err),
 		}
+		addSourcePosition(errorData, err)
 
 		// Extract additional fields from structured errors
-		switch e := err.(type) { //nolint:errorlint // Errors are not wrapped in this codebase
+		switch e := unlocated(err).(type) { //nolint:errorlint // Errors are not wrapped in this codebase
 		case MinSizeError:
 			errorData["minSize"] = e.MinSize
 			errorData["actualSize"] = e.ActualSize
@@ -69,6 +74,50 @@ func JSONDetailedPresenter(pathGlue string) PresenterFunc {
 			errorData["field"] = e.Field
 		case UnexpectedFieldError:
 			errorData["field"] = e.Field
+		case UnknownDiscriminatorError:
+			errorData["field"] = e.Field
+			errorData["value"] = e.Value
+			errorData["allowed"] = e.Allowed
+		case AnyOfError:
+			errorData["branches"] = presentBranches(ctx, pathPresenter, e.Branches)
+		case ExactlyOneOfError:
+			errorData["matched"] = e.Matched
+			errorData["branches"] = presentBranches(ctx, pathPresenter, e.Branches)
+		case AllOfError:
+			errorData["branches"] = presentBranches(ctx, pathPresenter, e.Branches)
+		case UnresolvedRefError:
+			errorData["ref"] = e.Ref
+		case MaxDepthExceededError:
+			errorData["maxDepth"] = e.MaxDepth
+		case InvalidKeyError:
+			errorData["key"] = e.Key
+			errorData["keyErrorType"] = getErrorType(e.Err)
+		case TupleLengthError:
+			errorData["minItems"] = e.MinItems
+			errorData["maxItems"] = e.MaxItems
+			errorData["actualItems"] = e.ActualItems
+		case FieldMismatchError:
+			errorData["field"] = e.Field
+			errorData["other"] = e.Other
+		case FieldComparisonError:
+			errorData["field"] = e.Field
+			errorData["other"] = e.Other
+			errorData["operator"] = e.Operator
+		case ConditionalRequiredError:
+			errorData["field"] = e.Field
+			errorData["trigger"] = e.Trigger
+			errorData["condition"] = e.Condition
+		case MutuallyExclusiveError:
+			errorData["field"] = e.Field
+			errorData["conflict"] = e.Conflict
+		case MissingFieldError:
+			errorData["field"] = e.Field
+		case CoercionError:
+			errorData["value"] = e.Value
+			errorData["target"] = e.Target
+		case DecodeTypeError:
+			errorData["value"] = e.Value
+			errorData["goType"] = e.Type
 		}
 
 		jsonBytes, jsonErr := json.Marshal(errorData)
@@ -81,11 +130,40 @@ func JSONDetailedPresenter(pathGlue string) PresenterFunc {
 	}
 }
 
+// addSourcePosition adds the position of a LocatedError to presented error data.
+func addSourcePosition(errorData map[string]any, err error) {
+	if located, ok := err.(LocatedError); ok { //nolint:errorlint // Only the outermost error is located
+		errorData["line"] = located.Position.Line
+		errorData["column"] = located.Position.Column
+		errorData["offset"] = located.Position.Offset
+	}
+}
+
+// presentBranches converts combinator branch errors into JSON-friendly structures.
+func presentBranches(ctx context.Context, pathPresenter PresenterFunc, branches []BranchErrors) []map[string]any {
+	out := make([]map[string]any, 0, len(branches))
+	for _, branch := range branches {
+		errs := make([]map[string]string, 0, len(branch.Errors))
+		for _, e := range branch.Errors {
+			errs = append(errs, map[string]string{
+				"path":    pathPresenter(ctx, e.Path, e.Err),
+				"message": e.Err.Error(),
+				"type":    getErrorType(e.Err),
+			})
+		}
+		out = append(out, map[string]any{
+			"index":  branch.Index,
+			"errors": errs,
+		})
+	}
+	return out
+}
+
 // getErrorType returns the type name of the error
 //
 //nolint:cyclop // High complexity is acceptable for comprehensive error type detection
 func getErrorType(err error) string {
-	switch err.(type) { //nolint:errorlint // Errors are not wrapped in this codebase
+	switch unlocated(err).(type) { //nolint:errorlint // Errors are not wrapped in this codebase
 	case RequiredError:
 		return "RequiredError"
 	case NotAStringError:
@@ -120,6 +198,40 @@ func getErrorType(err error) string {
 		return "FieldNotDefinedError"
 	case UnexpectedFieldError:
 		return "UnexpectedFieldError"
+	case UnknownDiscriminatorError:
+		return "UnknownDiscriminatorError"
+	case AnyOfError:
+		return "AnyOfError"
+	case ExactlyOneOfError:
+		return "ExactlyOneOfError"
+	case AllOfError:
+		return "AllOfError"
+	case UnresolvedRefError:
+		return "UnresolvedRefError"
+	case MaxDepthExceededError:
+		return "MaxDepthExceededError"
+	case InvalidKeyError:
+		return "InvalidKeyError"
+	case TupleLengthError:
+		return "TupleLengthError"
+	case FieldMismatchError:
+		return "FieldMismatchError"
+	case FieldComparisonError:
+		return "FieldComparisonError"
+	case ConditionalRequiredError:
+		return "ConditionalRequiredError"
+	case MutuallyExclusiveError:
+		return "MutuallyExclusiveError"
+	case MissingFieldError:
+		return "MissingFieldError"
+	case NullNotAllowedError:
+		return "NullNotAllowedError"
+	case CoercionError:
+		return "CoercionError"
+	case DecodeTypeError:
+		return "DecodeTypeError"
+	case InvalidJSONError:
+		return "InvalidJSONError"
 	default:
 		return "Error"
 	}
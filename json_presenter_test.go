@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"github.com/gstachniukrsk/govalidator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -314,3 +315,35 @@ func TestJSONPresenter_MissingErrorTypes(t *testing.T) {
 		}
 	})
 }
+
+func TestJSONPresenter_LocatedErrors(t *testing.T) {
+	err := govalidator.LocatedError{
+		Err:      govalidator.StringTooShortError{MinLength: 3},
+		Position: govalidator.SourcePosition{Offset: 12, Line: 2, Column: 11},
+		Length:   4,
+		Line:     `  "name": "Al"`,
+	}
+	path := []string{"$", "name"}
+
+	var plain map[string]any
+	require.NoError(t, json.Unmarshal([]byte(govalidator.JSONPresenter(".")(context.Background(), path, err)), &plain))
+	assert.Equal(t, map[string]any{
+		"path":    "$.name",
+		"message": "expected at least 3 characters",
+		"line":    2.0,
+		"column":  11.0,
+		"offset":  12.0,
+	}, plain)
+
+	var detailed map[string]any
+	require.NoError(t, json.Unmarshal([]byte(govalidator.JSONDetailedPresenter(".")(context.Background(), path, err)), &detailed))
+	assert.Equal(t, map[string]any{
+		"path":      "$.name",
+		"message":   "expected at least 3 characters",
+		"type":      "StringTooShortError",
+		"minLength": 3.0,
+		"line":      2.0,
+		"column":    11.0,
+		"offset":    12.0,
+	}, detailed)
+}
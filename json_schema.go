@@ -0,0 +1,593 @@
+package govalidator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// UnsupportedKeywordError is returned by FromJSONSchema for keywords it cannot express.
+type UnsupportedKeywordError struct {
+	Path    string
+	Keyword string
+}
+
+// InvalidKeywordError is returned by FromJSONSchema for keywords with a malformed or unsupported value.
+type InvalidKeywordError struct {
+	Path    string
+	Keyword string
+	Reason  string
+}
+
+// Error returns the error message.
+func (e UnsupportedKeywordError) Error() string {
+	return fmt.Sprintf("unsupported JSON Schema keyword %q at %s", e.Keyword, e.Path)
+}
+
+// Error returns the error message.
+func (e InvalidKeywordError) Error() string {
+	return fmt.Sprintf("invalid JSON Schema keyword %q at %s: %s", e.Keyword, e.Path, e.Reason)
+}
+
+// jsonSchemaKeyword applies a single JSON Schema keyword to the schema being built.
+type jsonSchemaKeyword func(l *jsonSchemaLoader, s *Schema, node map[string]any, value any, path string) error
+
+// jsonSchemaAnnotations are keywords that carry no validation and are ignored.
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"examples":    true,
+	"deprecated":  true,
+	"readOnly":    true,
+	"writeOnly":   true,
+}
+
+// jsonSchemaKeywords maps supported JSON Schema keywords to their implementation.
+// "type", "$ref" and "$defs" are handled by load itself.
+var jsonSchemaKeywords map[string]jsonSchemaKeyword
+
+func init() {
+	jsonSchemaKeywords = map[string]jsonSchemaKeyword{
+		"properties":           loadProperties,
+		"required":             loadRequired,
+		"dependentRequired":    loadDependentRequired,
+		"additionalProperties": loadAdditionalProperties,
+		"patternProperties":    loadPatternProperties,
+		"items":                loadItems,
+		"prefixItems":          loadPrefixItems,
+		"enum":                 loadEnum,
+		"const":                loadConst,
+		"minLength":            validatorKeyword("min_length", "string"),
+		"maxLength":            validatorKeyword("max_length", "string"),
+		"minItems":             validatorKeyword("min_size", "array"),
+		"maxItems":             validatorKeyword("max_size", "array"),
+		"minimum":              validatorKeyword("min_float", "number"),
+		"maximum":              validatorKeyword("max_float", "number"),
+		"pattern":              validatorKeyword("regex", "string"),
+		"format":               loadFormat,
+		"allOf":                branchesKeyword(func(s *Schema, b []*Schema) { s.allOf = b }),
+		"anyOf":                branchesKeyword(func(s *Schema, b []*Schema) { s.anyOf = b }),
+		"oneOf":                branchesKeyword(func(s *Schema, b []*Schema) { s.exactlyOneOf = b }),
+		"if":                   subschemaKeyword(func(s *Schema, sub *Schema) { s.If(sub) }),
+		"then":                 subschemaKeyword(func(s *Schema, sub *Schema) { s.Then(sub) }),
+		"else":                 subschemaKeyword(func(s *Schema, sub *Schema) { s.Else(sub) }),
+		"default": func(_ *jsonSchemaLoader, s *Schema, _ map[string]any, value any, _ string) error {
+			s.Default(value)
+			return nil
+		},
+	}
+}
+
+// jsonSchemaTypes maps JSON Schema type names to type validators.
+var jsonSchemaTypes = map[string]ContextValidator{
+	"string":  IsStringValidator,
+	"integer": IsIntegerValidator,
+	"number":  NumberValidator,
+	"boolean": IsBooleanValidator,
+	"object":  IsMapValidator,
+	"array":   IsListValidator,
+}
+
+// jsonSchemaFormats maps JSON Schema formats to the names of their validators.
+var jsonSchemaFormats = map[string]string{
+	"email": "email",
+	"uuid":  "uuid",
+	"uri":   "url",
+	"ipv4":  "ipv4",
+	"ipv6":  "ipv6",
+}
+
+// FromJSONSchema builds a schema from a JSON Schema (draft 2020-12) document.
+//
+// Supported keywords: type, properties, required, dependentRequired,
+// additionalProperties, patternProperties, items, prefixItems, enum, const,
+// minLength, maxLength, minItems, maxItems, minimum, maximum, pattern,
+// format (email, uuid, uri, ipv4, ipv6), $ref and $defs, allOf, anyOf, oneOf,
+// if, then, else and default. Annotations such as title and description and
+// extensions starting with "x-" are ignored. Any other keyword returns an
+// UnsupportedKeywordError.
+//
+// A value of a type not listed in "type" is rejected, including null. Keywords
+// that apply to one type, such as minLength, accept values of other types.
+//
+// Example:
+//
+//	schema, err := FromJSONSchema([]byte(`{
+//	    "type": "object",
+//	    "properties": {"email": {"type": "string", "format": "email"}},
+//	    "required": ["email"]
+//	}`))
+func FromJSONSchema(data []byte) (*Schema, error) {
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema document: %w", err)
+	}
+
+	l := &jsonSchemaLoader{}
+	root, err := l.load(doc, "#")
+	if err != nil {
+		return nil, err
+	}
+	if l.rootReferenced {
+		root.Define("#", root)
+	}
+	return root, nil
+}
+
+// jsonSchemaLoader holds state shared while loading one document.
+type jsonSchemaLoader struct {
+	rootReferenced bool
+}
+
+// load builds the schema for a JSON Schema node found at path.
+func (l *jsonSchemaLoader) load(node any, path string) (*Schema, error) {
+	switch n := node.(type) {
+	case bool:
+		if n {
+			return NewSchema(), nil
+		}
+		return nil, InvalidKeywordError{Path: path, Keyword: "false", Reason: "false schemas are only supported in additionalProperties and items"}
+	case map[string]any:
+		return l.loadObject(n, path)
+	default:
+		return nil, InvalidKeywordError{Path: path, Keyword: "schema", Reason: "expected an object or a boolean"}
+	}
+}
+
+// loadObject builds the schema for a JSON Schema object node.
+func (l *jsonSchemaLoader) loadObject(node map[string]any, path string) (*Schema, error) {
+	s := NewSchema()
+
+	if err := l.loadType(s, node, path); err != nil {
+		return nil, err
+	}
+	if err := l.loadDefs(s, node, path); err != nil {
+		return nil, err
+	}
+
+	// "properties" goes first so that "required" can find the declared fields
+	keywords := sortedKeys(node)
+	sort.SliceStable(keywords, func(i, j int) bool {
+		return keywords[i] == "properties" && keywords[j] != "properties"
+	})
+
+	constrained := false
+	for _, keyword := range keywords {
+		if jsonSchemaAnnotations[keyword] || strings.HasPrefix(keyword, "x-") ||
+			keyword == "type" || keyword == "$defs" || keyword == "$ref" {
+			continue
+		}
+		handler, ok := jsonSchemaKeywords[keyword]
+		if !ok {
+			return nil, UnsupportedKeywordError{Path: path, Keyword: keyword}
+		}
+		if err := handler(l, s, node, node[keyword], path+"/"+keyword); err != nil {
+			return nil, err
+		}
+		constrained = true
+	}
+
+	ref, hasRef := node["$ref"]
+	if !hasRef {
+		return s, nil
+	}
+
+	target, err := l.loadRef(ref, path+"/$ref")
+	if err != nil {
+		return nil, err
+	}
+	_, typed := node["type"]
+	if !constrained && !typed {
+		// A bare reference keeps the definitions next to it in scope
+		for name, def := range s.definitions {
+			target.Define(name, def)
+		}
+		return target, nil
+	}
+	s.allOf = append(s.allOf, target)
+	return s, nil
+}
+
+// loadType adds the type validator and rejects null unless "null" is an allowed type.
+func (l *jsonSchemaLoader) loadType(s *Schema, node map[string]any, path string) error {
+	value, ok := node["type"]
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	switch v := value.(type) {
+	case string:
+		names = []string{v}
+	case []any:
+		for _, item := range v {
+			name, isString := item.(string)
+			if !isString {
+				return InvalidKeywordError{Path: path + "/type", Keyword: "type", Reason: "expected a string or an array of strings"}
+			}
+			names = append(names, name)
+		}
+	default:
+		return InvalidKeywordError{Path: path + "/type", Keyword: "type", Reason: "expected a string or an array of strings"}
+	}
+
+	nullable := false
+	var branches []*Schema
+	for _, name := range names {
+		if name == "null" {
+			nullable = true
+			continue
+		}
+		validator, known := jsonSchemaTypes[name]
+		if !known {
+			return InvalidKeywordError{Path: path + "/type", Keyword: "type", Reason: fmt.Sprintf("unknown type %q", name)}
+		}
+		branches = append(branches, NewSchema(validator))
+	}
+
+	switch {
+	case len(branches) == 1:
+		s.Validators = append(s.Validators, branches[0].Validators...)
+	case len(branches) > 1:
+		s.anyOf = append(s.anyOf, branches...)
+	default:
+		// Only null is allowed
+		s.Validators = append(s.Validators, OneOfValidator(nil))
+	}
+
+	if !nullable {
+		s.NotNull()
+	}
+	return nil
+}
+
+// loadDefs defines the schemas of "$defs" so that "#/$defs/<name>" references resolve.
+func (l *jsonSchemaLoader) loadDefs(s *Schema, node map[string]any, path string) error {
+	value, ok := node["$defs"]
+	if !ok {
+		return nil
+	}
+	defs, isObject := value.(map[string]any)
+	if !isObject {
+		return InvalidKeywordError{Path: path + "/$defs", Keyword: "$defs", Reason: "expected an object"}
+	}
+	for _, name := range sortedKeys(defs) {
+		def, err := l.load(defs[name], path+"/$defs/"+name)
+		if err != nil {
+			return err
+		}
+		s.Define("#/$defs/"+name, def)
+	}
+	return nil
+}
+
+// loadRef resolves "#" and "#/$defs/<name>" references.
+func (l *jsonSchemaLoader) loadRef(value any, path string) (*Schema, error) {
+	ref, ok := value.(string)
+	if !ok {
+		return nil, InvalidKeywordError{Path: path, Keyword: "$ref", Reason: "expected a string"}
+	}
+	if ref == "#" {
+		l.rootReferenced = true
+		return Ref("#"), nil
+	}
+	if !strings.HasPrefix(ref, "#/$defs/") || strings.Count(ref, "/") != 2 {
+		return nil, InvalidKeywordError{Path: path, Keyword: "$ref", Reason: fmt.Sprintf("only local references to $defs are supported, got %q", ref)}
+	}
+	return Ref(ref), nil
+}
+
+// loadProperties declares a field for every property.
+func loadProperties(l *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+	properties, ok := value.(map[string]any)
+	if !ok {
+		return InvalidKeywordError{Path: path, Keyword: "properties", Reason: "expected an object"}
+	}
+	if s.Fields == nil {
+		s.Fields = make(map[string]*Schema, len(properties))
+	}
+	for _, name := range sortedKeys(properties) {
+		property, err := l.load(properties[name], path+"/"+name)
+		if err != nil {
+			return err
+		}
+		s.Fields[name] = property
+	}
+	return nil
+}
+
+// loadRequired requires the presence of the listed properties. Names missing
+// from "properties" are not declared as fields, so additionalProperties still applies to them.
+func loadRequired(_ *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+	names, err := stringList(value, path, "required")
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if field, declared := s.Fields[name]; declared && field != nil {
+			field.Present()
+			continue
+		}
+		if !slices.Contains(s.presentKeys, name) {
+			s.presentKeys = append(s.presentKeys, name)
+		}
+	}
+	return nil
+}
+
+// loadDependentRequired requires the listed properties when the named property is present.
+// Like "required", names missing from "properties" are not declared as fields.
+func loadDependentRequired(_ *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+	dependencies, ok := value.(map[string]any)
+	if !ok {
+		return InvalidKeywordError{Path: path, Keyword: "dependentRequired", Reason: "expected an object"}
+	}
+	for _, trigger := range sortedKeys(dependencies) {
+		names, err := stringList(dependencies[trigger], path+"/"+trigger, "dependentRequired")
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if field, declared := s.Fields[name]; declared && field != nil {
+				field.RequiredWith(trigger)
+				continue
+			}
+			s.keyConditions = append(s.keyConditions, keyCondition{name: name, condition: requiredWithCondition(trigger)})
+		}
+	}
+	return nil
+}
+
+// loadAdditionalProperties forbids or validates properties not declared by the schema.
+func loadAdditionalProperties(l *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+	if s.Fields == nil {
+		s.Fields = map[string]*Schema{}
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			s.Extra = ExtraIgnore
+		} else {
+			s.Extra = ExtraForbid
+		}
+		return nil
+	default:
+		values, err := l.load(v, path)
+		if err != nil {
+			return err
+		}
+		s.Values(values)
+		return nil
+	}
+}
+
+// loadPatternProperties validates properties whose names match a pattern.
+func loadPatternProperties(l *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+	patterns, ok := value.(map[string]any)
+	if !ok {
+		return InvalidKeywordError{Path: path, Keyword: "patternProperties", Reason: "expected an object"}
+	}
+	for _, pattern := range sortedKeys(patterns) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return InvalidKeywordError{Path: path, Keyword: "patternProperties", Reason: err.Error()}
+		}
+		property, err := l.load(patterns[pattern], path+"/"+pattern)
+		if err != nil {
+			return err
+		}
+		s.PatternField(re, property)
+	}
+	return nil
+}
+
+// loadItems sets the schema of array items, or of the items after "prefixItems".
+func loadItems(l *jsonSchemaLoader, s *Schema, node map[string]any, value any, path string) error {
+	if allowed, ok := value.(bool); ok && !allowed {
+		if _, tuple := node["prefixItems"]; !tuple {
+			addTypedValidator(s, node, "array", MaxSizeValidator(0, false))
+			return nil
+		}
+		s.closedTuple = true
+		return nil
+	}
+	items, err := l.load(value, path)
+	if err != nil {
+		return err
+	}
+	s.Items = items
+	return nil
+}
+
+// loadPrefixItems sets positional item schemas.
+func loadPrefixItems(l *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+	list, ok := value.([]any)
+	if !ok {
+		return InvalidKeywordError{Path: path, Keyword: "prefixItems", Reason: "expected an array"}
+	}
+	s.prefixItems = make([]*Schema, 0, len(list))
+	for i, item := range list {
+		itemSchema, err := l.load(item, fmt.Sprintf("%s/%d", path, i))
+		if err != nil {
+			return err
+		}
+		s.prefixItems = append(s.prefixItems, itemSchema)
+	}
+	return nil
+}
+
+// loadEnum restricts the value to the listed values.
+func loadEnum(_ *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+	options, ok := value.([]any)
+	if !ok {
+		return InvalidKeywordError{Path: path, Keyword: "enum", Reason: "expected an array"}
+	}
+	s.Validators = append(s.Validators, OneOfValidator(options...))
+	return nil
+}
+
+// loadConst restricts the value to a single value.
+func loadConst(_ *jsonSchemaLoader, s *Schema, _ map[string]any, value any, _ string) error {
+	s.Validators = append(s.Validators, OneOfValidator(value))
+	return nil
+}
+
+// loadFormat adds the validator of a supported format.
+func loadFormat(_ *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+	format, _ := value.(string)
+	name, ok := jsonSchemaFormats[format]
+	if !ok {
+		return InvalidKeywordError{Path: path, Keyword: "format", Reason: fmt.Sprintf("unsupported format %q", fmt.Sprint(value))}
+	}
+	validator, err := DefaultValidatorRegistry.New(name)
+	if err != nil {
+		return err
+	}
+	s.Validators = append(s.Validators, validator)
+	return nil
+}
+
+// validatorKeyword adapts a registered validator taking the keyword value as its argument
+// to a keyword that applies to values of the JSON type jsonType.
+func validatorKeyword(name, jsonType string) jsonSchemaKeyword {
+	return func(_ *jsonSchemaLoader, s *Schema, node map[string]any, value any, path string) error {
+		validator, err := DefaultValidatorRegistry.New(name, value)
+		if err != nil {
+			var argErr ValidatorArgumentError
+			if errors.As(err, &argErr) {
+				return InvalidKeywordError{Path: path, Keyword: lastSegment(path), Reason: argErr.Reason}
+			}
+			return err
+		}
+		addTypedValidator(s, node, jsonType, validator)
+		return nil
+	}
+}
+
+// addTypedValidator adds the validator of a keyword that applies to values of
+// one JSON type. Unless "type" limits the value to that type, the validator
+// runs only for values of the type, so that other values are accepted.
+func addTypedValidator(s *Schema, node map[string]any, jsonType string, validator ContextValidator) {
+	if !typedAs(node, jsonType) {
+		validator = typedValidator(jsonSchemaTypes[jsonType], validator)
+	}
+	s.Validators = append(s.Validators, validator)
+}
+
+// typedValidator runs the built-in validator only for values the type validator accepts.
+// It keeps the descriptor of the validator, which exports to the same keyword.
+func typedValidator(typeValidator, validator ContextValidator) ContextValidator {
+	info, _ := describeValidator(validator)
+	return describe(func(ctx context.Context, value any) (bool, []error) {
+		if _, errs := typeValidator(ctx, value); len(errs) > 0 {
+			return false, nil
+		}
+		return validator(ctx, value)
+	}, info)
+}
+
+// typedAs reports whether the "type" of the node only allows null and values of the JSON type.
+// Integers are numbers.
+func typedAs(node map[string]any, jsonType string) bool {
+	var names []any
+	switch v := node["type"].(type) {
+	case string:
+		names = []any{v}
+	case []any:
+		names = v
+	}
+
+	typed := false
+	for _, name := range names {
+		switch {
+		case name == "null":
+		case name == jsonType, jsonType == "number" && name == "integer":
+			typed = true
+		default:
+			return false
+		}
+	}
+	return typed
+}
+
+// branchesKeyword adapts a combinator setter to a keyword taking a list of schemas.
+func branchesKeyword(set func(*Schema, []*Schema)) jsonSchemaKeyword {
+	return func(l *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+		list, ok := value.([]any)
+		if !ok || len(list) == 0 {
+			return InvalidKeywordError{Path: path, Keyword: lastSegment(path), Reason: "expected a non-empty array"}
+		}
+		branches := make([]*Schema, 0, len(list))
+		for i, item := range list {
+			branch, err := l.load(item, fmt.Sprintf("%s/%d", path, i))
+			if err != nil {
+				return err
+			}
+			branches = append(branches, branch)
+		}
+		set(s, branches)
+		return nil
+	}
+}
+
+// subschemaKeyword adapts a setter to a keyword taking a single schema.
+func subschemaKeyword(set func(*Schema, *Schema)) jsonSchemaKeyword {
+	return func(l *jsonSchemaLoader, s *Schema, _ map[string]any, value any, path string) error {
+		sub, err := l.load(value, path)
+		if err != nil {
+			return err
+		}
+		set(s, sub)
+		return nil
+	}
+}
+
+// stringList converts a JSON array of strings.
+func stringList(value any, path string, keyword string) ([]string, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, InvalidKeywordError{Path: path, Keyword: keyword, Reason: "expected an array of strings"}
+	}
+	names := make([]string, 0, len(list))
+	for _, item := range list {
+		name, isString := item.(string)
+		if !isString {
+			return nil, InvalidKeywordError{Path: path, Keyword: keyword, Reason: "expected an array of strings"}
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// lastSegment returns the keyword at the end of a JSON pointer.
+func lastSegment(path string) string {
+	return path[strings.LastIndex(path, "/")+1:]
+}
@@ -0,0 +1,595 @@
+package govalidator
+
+import (
+	"context"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// jsonSchemaDialect identifies the JSON Schema version of exported documents.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaTypeNames maps built-in type validators to JSON Schema types.
+var jsonSchemaTypeNames = map[string]string{
+	"is_string":  "string",
+	"is_integer": "integer",
+	"number":     "number",
+	"float":      "number",
+	"is_boolean": "boolean",
+	"is_list":    "array",
+	"is_map":     "object",
+	"time":       "string",
+}
+
+// ToJSONSchema describes the schema as a JSON Schema (draft 2020-12) document,
+// ready to be encoded with encoding/json.
+//
+// Built-in validators become the matching keywords, for example MinLengthValidator(3)
+// becomes "minLength": 3, OneOfValidator becomes "enum" and EmailValidator becomes
+// "format": "email". Validators, transformers and rules without a JSON Schema
+// counterpart, including custom ones, are listed by name under "x-validators",
+// "x-transformers" and "x-rules". Definitions are collected under "$defs".
+//
+// Example:
+//
+//	doc, _ := json.MarshalIndent(schema.ToJSONSchema(), "", "  ")
+func (s *Schema) ToJSONSchema() map[string]any {
+	e := newJSONSchemaExporter("#/$defs/")
+	e.root = s
+	node := e.export(s)
+	if len(e.defs) > 0 {
+		node["$defs"] = e.defs
+	}
+	node["$schema"] = jsonSchemaDialect
+	return node
+}
+
+// jsonSchemaExporter converts schemas to JSON Schema nodes, collecting definitions
+// from every scope into a single object, such as "$defs", found at refPrefix.
+type jsonSchemaExporter struct {
+	defs      map[string]any
+	keys      map[*Schema]string
+	scope     *exportScope
+	refPrefix string
+
+	// root is the schema at the top of the document being exported, which "#" refers to
+	root *Schema
+}
+
+// exportScope maps the definition names visible at a point of the schema to JSON pointers.
+type exportScope struct {
+	refs   map[string]string
+	parent *exportScope
+}
+
+func newJSONSchemaExporter(refPrefix string) *jsonSchemaExporter {
+	return &jsonSchemaExporter{
+		defs:      make(map[string]any),
+		keys:      make(map[*Schema]string),
+		refPrefix: refPrefix,
+	}
+}
+
+// export returns the node of a schema. A schema that is itself a definition
+// is exported once under "$defs" and referenced from everywhere else.
+func (e *jsonSchemaExporter) export(s *Schema) map[string]any {
+	if s == nil {
+		return map[string]any{}
+	}
+
+	leave := e.enter(s)
+	defer leave()
+
+	if key, ok := e.keys[s]; ok {
+		return map[string]any{"$ref": e.pointer(key)}
+	}
+	return e.node(s)
+}
+
+// enter makes the definitions of a schema visible to references and exports
+// those not seen before. Returns a function restoring the previous scope.
+func (e *jsonSchemaExporter) enter(s *Schema) func() {
+	if len(s.definitions) == 0 {
+		return func() {}
+	}
+
+	scope := &exportScope{refs: make(map[string]string, len(s.definitions)), parent: e.scope}
+	e.scope = scope
+
+	// Register every name first so that definitions can refer to each other
+	var pending []*Schema
+	for _, name := range slices.Sorted(maps.Keys(s.definitions)) {
+		def := s.definitions[name]
+		if name == "#" && def == e.root {
+			scope.refs[name] = e.rootRef()
+			continue
+		}
+		key, ok := e.keys[def]
+		if !ok {
+			key = e.newKey(name)
+			e.keys[def] = key
+			pending = append(pending, def)
+		}
+		scope.refs[name] = e.pointer(key)
+	}
+
+	for _, def := range pending {
+		leaveDef := e.enter(def)
+		e.defs[e.keys[def]] = e.node(def)
+		leaveDef()
+	}
+
+	return func() { e.scope = scope.parent }
+}
+
+// newKey reserves a unique "$defs" key for a definition name.
+func (e *jsonSchemaExporter) newKey(name string) string {
+	base := strings.TrimPrefix(name, "#/$defs/")
+	if base == "#" {
+		// The root of a nested document, such as an imported JSON Schema
+		base = "root"
+	}
+	key := base
+	for i := 2; ; i++ {
+		if _, taken := e.defs[key]; !taken {
+			break
+		}
+		key = base + "_" + strconv.Itoa(i)
+	}
+	e.defs[key] = nil
+	return key
+}
+
+// resolve returns the JSON pointer of a definition name visible in the current scope.
+func (e *jsonSchemaExporter) resolve(name string) string {
+	for scope := e.scope; scope != nil; scope = scope.parent {
+		if ref, ok := scope.refs[name]; ok {
+			return ref
+		}
+	}
+	if name == "#" {
+		return e.rootRef()
+	}
+	return e.pointer(strings.TrimPrefix(name, "#/$defs/"))
+}
+
+// rootRef returns the JSON pointer of the root schema: "#" for a standalone
+// document, or its own entry when the root is one of several named schemas.
+func (e *jsonSchemaExporter) rootRef() string {
+	if key, ok := e.keys[e.root]; ok {
+		return e.pointer(key)
+	}
+	return "#"
+}
+
+// pointer returns the JSON pointer of a definition.
+func (e *jsonSchemaExporter) pointer(key string) string {
+	return e.refPrefix + escapeJSONPointer(key)
+}
+
+// escapeJSONPointer escapes a name for use as a JSON pointer segment.
+func escapeJSONPointer(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
+
+// node builds the JSON Schema node of a schema without looking at its own definitions.
+func (e *jsonSchemaExporter) node(s *Schema) map[string]any {
+	n := &exportNode{node: map[string]any{}}
+
+	if s.ref != "" {
+		// The target decides about null unless the reference itself rejects it
+		n.node["$ref"] = e.resolve(s.ref)
+		return n.finish(s)
+	}
+
+	e.addValidators(n, s.Validators)
+	e.addTransformers(n, s.transformers)
+	if s.isObject() {
+		e.addObject(n, s)
+	}
+	if s.isArray() {
+		e.addArray(n, s)
+	}
+
+	if len(s.anyOf) > 0 {
+		n.node["anyOf"] = e.exportAll(s.anyOf)
+		n.branches = true
+	}
+	if len(s.exactlyOneOf) > 0 {
+		n.node["oneOf"] = e.exportAll(s.exactlyOneOf)
+		n.branches = true
+	}
+	for _, branch := range s.allOf {
+		n.allOf = append(n.allOf, e.export(branch))
+		n.branches = true
+	}
+	if s.ifSchema != nil {
+		n.branches = true
+		n.node["if"] = e.export(s.ifSchema)
+		if s.thenSchema != nil {
+			n.node["then"] = e.export(s.thenSchema)
+		}
+		if s.elseSchema != nil {
+			n.node["else"] = e.export(s.elseSchema)
+		}
+	}
+
+	if s.staticDefault {
+		n.node["default"] = s.defaultFunc(context.Background())
+	}
+
+	return n.finish(s)
+}
+
+// exportAll exports a list of schemas.
+func (e *jsonSchemaExporter) exportAll(schemas []*Schema) []any {
+	nodes := make([]any, len(schemas))
+	for i, schema := range schemas {
+		nodes[i] = e.export(schema)
+	}
+	return nodes
+}
+
+// addValidators translates validators into keywords, listing the others under "x-validators".
+//
+//nolint:cyclop // One case per built-in validator
+func (e *jsonSchemaExporter) addValidators(n *exportNode, validators []ContextValidator) {
+	for _, validator := range validators {
+		info, ok := describeValidator(validator)
+		if !ok {
+			n.extension("x-validators", map[string]any{"name": funcName(validator)})
+			continue
+		}
+		if !info.builtin {
+			n.extension("x-validators", extensionEntry(info))
+			continue
+		}
+
+		if typeName, isType := jsonSchemaTypeNames[info.name]; isType {
+			n.setType(typeName)
+		}
+
+		switch info.name {
+		case "is_string", "is_integer", "number", "is_boolean", "is_list", "is_map", "nullable", "non_nullable":
+			// Expressed by the type and by whether null is accepted
+		case "float":
+			n.set("multipleOf", math.Pow10(-info.arg(0).(int)))
+		case "min_length":
+			n.set("minLength", info.arg(0))
+		case "max_length":
+			n.set("maxLength", info.arg(0))
+		case "min_size":
+			n.set("minItems", info.arg(0))
+		case "max_size":
+			n.set("maxItems", info.arg(0))
+		case "min_float":
+			n.set("minimum", info.arg(0))
+		case "max_float":
+			n.set("maximum", info.arg(0))
+		case "one_of":
+			n.set("enum", slices.Clone(info.arg(0).([]any)))
+		case "regex":
+			n.set("pattern", info.arg(0))
+		case "email", "uuid", "ipv4", "ipv6":
+			n.set("format", info.name)
+		case "url":
+			n.set("format", "uri")
+		case "time":
+			n.set("format", "date-time")
+		case "xid":
+			n.set("pattern", "^[0-9a-v]{20}$")
+		case "base64":
+			n.set("contentEncoding", "base64")
+		case "json":
+			n.set("contentMediaType", "application/json")
+		default:
+			n.extension("x-validators", extensionEntry(info))
+		}
+	}
+}
+
+// extensionEntry describes a validator or rule in an extension keyword.
+func extensionEntry(info validatorInfo) map[string]any {
+	entry := map[string]any{"name": info.name}
+	if info.description != "" {
+		entry["description"] = info.description
+	}
+	if params := info.paramMap(); params != nil {
+		entry["params"] = params
+	}
+	return entry
+}
+
+// addTransformers lists transformers under "x-transformers". Conversions from
+// strings widen the type, since the input may be a string.
+func (e *jsonSchemaExporter) addTransformers(n *exportNode, transformers []Transformer) {
+	for _, transformer := range transformers {
+		name := describeTransformer(transformer)
+		n.extension("x-transformers", name)
+		switch name {
+		case "string_to_int", "string_to_float", "string_to_bool":
+			n.widenType("string")
+		}
+	}
+}
+
+// addObject adds properties, presence requirements and extra field handling.
+func (e *jsonSchemaExporter) addObject(n *exportNode, s *Schema) {
+	n.setType("object")
+
+	properties := make(map[string]any, len(s.Fields))
+	var required []string
+	for _, name := range slices.Sorted(maps.Keys(s.Fields)) {
+		field := s.Fields[name]
+		properties[name] = e.export(field)
+		if field == nil {
+			continue
+		}
+		if field.required || field.mustBePresent {
+			required = append(required, name)
+		}
+		for _, condition := range field.conditions {
+			n.allOf = append(n.allOf, conditionNode(name, condition))
+		}
+	}
+
+	for _, name := range s.presentKeys {
+		if !slices.Contains(required, name) {
+			required = append(required, name)
+		}
+	}
+	for _, kc := range s.keyConditions {
+		n.allOf = append(n.allOf, conditionNode(kc.name, kc.condition))
+	}
+
+	if s.discriminator != nil {
+		e.addDiscriminator(n, s, properties)
+		if !slices.Contains(required, s.discriminator.field) {
+			required = append(required, s.discriminator.field)
+		}
+	}
+
+	if len(properties) > 0 {
+		n.node["properties"] = properties
+	}
+	if len(required) > 0 {
+		n.node["required"] = required
+	}
+
+	if len(s.patternFields) > 0 {
+		patterns := make(map[string]any, len(s.patternFields))
+		for _, pf := range s.patternFields {
+			if _, taken := patterns[pf.pattern.String()]; taken {
+				n.allOf = append(n.allOf, map[string]any{
+					"patternProperties": map[string]any{pf.pattern.String(): e.export(pf.schema)},
+				})
+				continue
+			}
+			patterns[pf.pattern.String()] = e.export(pf.schema)
+		}
+		n.node["patternProperties"] = patterns
+	}
+
+	if s.keys != nil {
+		names := &exportNode{node: map[string]any{}}
+		e.addValidators(names, s.keys)
+		n.node["propertyNames"] = names.finishConstraints()
+	}
+
+	// Discriminator branches declare fields in subschemas, which additionalProperties cannot see
+	additional := "additionalProperties"
+	if s.discriminator != nil {
+		additional = "unevaluatedProperties"
+	}
+	switch {
+	case s.values != nil:
+		n.node[additional] = e.export(s.values)
+	case s.Extra == ExtraForbid:
+		n.node[additional] = false
+	}
+
+	for _, rule := range s.rules {
+		entry := map[string]any{"name": funcName(rule)}
+		if info, ok := describeRule(rule); ok {
+			entry = extensionEntry(info)
+		}
+		n.extension("x-rules", entry)
+	}
+}
+
+// addDiscriminator restricts the tag field to the known values and applies each
+// branch when the tag selects it.
+func (e *jsonSchemaExporter) addDiscriminator(n *exportNode, s *Schema, properties map[string]any) {
+	d := s.discriminator
+	allowed := d.allowed()
+	n.branches = true
+	tags := make([]any, len(allowed))
+	for i, tag := range allowed {
+		tags[i] = tag
+	}
+
+	if _, declared := properties[d.field]; declared {
+		n.allOf = append(n.allOf, map[string]any{
+			"properties": map[string]any{d.field: map[string]any{"enum": tags}},
+		})
+	} else {
+		properties[d.field] = map[string]any{"enum": tags}
+	}
+
+	for _, tag := range allowed {
+		n.allOf = append(n.allOf, map[string]any{
+			"if": map[string]any{
+				"properties": map[string]any{d.field: map[string]any{"const": tag}},
+				"required":   []string{d.field},
+			},
+			"then": e.export(d.mapping[tag]),
+		})
+	}
+}
+
+// addArray adds item schemas and tuple bounds.
+func (e *jsonSchemaExporter) addArray(n *exportNode, s *Schema) {
+	n.setType("array")
+
+	if s.prefixItems != nil {
+		n.node["prefixItems"] = e.exportAll(s.prefixItems)
+		if minItems, _ := s.tupleBounds(); minItems > 0 {
+			n.set("minItems", minItems)
+		}
+	}
+	switch {
+	case s.prefixItems != nil && s.closedTuple:
+		n.node["items"] = false
+	case s.Items != nil:
+		n.node["items"] = e.export(s.Items)
+	}
+}
+
+// conditionNode expresses a conditional requirement of a field as an if/then subschema.
+// A path is present when it exists and is not null, as for the validator.
+func conditionNode(field string, condition fieldCondition) map[string]any {
+	present := presenceNode([]string{field}, notNullNode())
+	var nodes []any
+	for _, path := range condition.paths {
+		segments := strings.Split(path, ".")
+		switch condition.kind {
+		case "required_if":
+			nodes = append(nodes, map[string]any{
+				"if":   presenceNode(segments, map[string]any{"const": condition.value}),
+				"then": present,
+			})
+		case "required_unless":
+			nodes = append(nodes, map[string]any{
+				"if":   map[string]any{"not": presenceNode(segments, map[string]any{"const": condition.value})},
+				"then": present,
+			})
+		case "required_with":
+			nodes = append(nodes, map[string]any{"if": presenceNode(segments, notNullNode()), "then": present})
+		case "required_without":
+			nodes = append(nodes, map[string]any{
+				"if":   map[string]any{"not": presenceNode(segments, notNullNode())},
+				"then": present,
+			})
+		case "mutually_exclusive":
+			nodes = append(nodes, map[string]any{
+				"if":   present,
+				"then": map[string]any{"not": presenceNode(segments, notNullNode())},
+			})
+		}
+	}
+	if len(nodes) == 1 {
+		return nodes[0].(map[string]any)
+	}
+	return map[string]any{"allOf": nodes}
+}
+
+// presenceNode matches objects where the nested field at segments exists and matches leaf.
+func presenceNode(segments []string, leaf map[string]any) map[string]any {
+	node := leaf
+	for i := len(segments) - 1; i >= 0; i-- {
+		node = map[string]any{
+			"type":       "object",
+			"required":   []string{segments[i]},
+			"properties": map[string]any{segments[i]: node},
+		}
+	}
+	return node
+}
+
+// notNullNode matches any value except null.
+func notNullNode() map[string]any {
+	return map[string]any{"not": map[string]any{"type": "null"}}
+}
+
+// exportNode accumulates the keywords of a JSON Schema node. Keywords that
+// would be set twice with different values are moved to allOf. branches is set
+// when the node applies subschemas that could reject null.
+type exportNode struct {
+	node     map[string]any
+	allOf    []any
+	branches bool
+}
+
+// set adds a keyword, or an allOf entry if the keyword already has another value.
+func (n *exportNode) set(keyword string, value any) {
+	current, ok := n.node[keyword]
+	switch {
+	case !ok:
+		n.node[keyword] = value
+	case !reflect.DeepEqual(current, value):
+		n.allOf = append(n.allOf, map[string]any{keyword: value})
+	}
+}
+
+// setType sets the type. "integer" narrows "number" and an existing type satisfies implied ones.
+func (n *exportNode) setType(typeName string) {
+	current, ok := n.node["type"]
+	switch {
+	case !ok:
+		n.node["type"] = typeName
+	case current == "number" && typeName == "integer":
+		n.node["type"] = typeName
+	case current == "integer" && typeName == "number":
+		// integer already implies number
+	default:
+		n.set("type", typeName)
+	}
+}
+
+// widenType adds a type to the accepted types, if the node has a type.
+func (n *exportNode) widenType(typeName string) {
+	switch current := n.node["type"].(type) {
+	case string:
+		if current != typeName {
+			n.node["type"] = []any{current, typeName}
+		}
+	case []any:
+		if !slices.Contains(current, any(typeName)) {
+			n.node["type"] = append(current, typeName)
+		}
+	}
+}
+
+// extension appends an entry to a list-valued extension keyword.
+func (n *exportNode) extension(keyword string, entry any) {
+	entries, _ := n.node[keyword].([]any)
+	n.node[keyword] = append(entries, entry)
+}
+
+// finishConstraints merges the collected allOf entries into the node.
+func (n *exportNode) finishConstraints() map[string]any {
+	if len(n.allOf) > 0 {
+		n.node["allOf"] = n.allOf
+	}
+	return n.node
+}
+
+// finish merges allOf entries and expresses whether the schema accepts null.
+// Validators and subschemas are not applied to null, so a nullable node with
+// branches becomes an anyOf of null and the node.
+func (n *exportNode) finish(s *Schema) map[string]any {
+	node := n.finishConstraints()
+
+	nullable := !s.required && !s.notNull
+	if !nullable {
+		_, typed := node["type"]
+		_, enumerated := node["enum"]
+		if !typed && !enumerated {
+			node["not"] = map[string]any{"type": "null"}
+		}
+		return node
+	}
+
+	if n.branches {
+		return map[string]any{"anyOf": []any{map[string]any{"type": "null"}, node}}
+	}
+	n.widenType("null")
+	if enum, ok := node["enum"].([]any); ok && !slices.Contains(enum, nil) {
+		node["enum"] = append(enum, nil)
+	}
+	return node
+}
@@ -0,0 +1,484 @@
+package govalidator_test
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportedJSON returns the JSON Schema export of a schema without the $schema keyword.
+func exportedJSON(t *testing.T, schema *govalidator.Schema) string {
+	t.Helper()
+	doc := schema.ToJSONSchema()
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", doc["$schema"])
+	delete(doc, "$schema")
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func noProfanity(_ context.Context, _ any) (bool, []error) {
+	return false, nil
+}
+
+func noSelfReview(_ context.Context, _ map[string]any) []govalidator.PathError {
+	return nil
+}
+
+func TestSchema_ToJSONSchema_Validators(t *testing.T) {
+	tests := []struct {
+		name       string
+		validators []govalidator.ContextValidator
+		want       string
+	}{
+		{name: "string", validators: []govalidator.ContextValidator{govalidator.IsStringValidator}, want: `{"type": "string"}`},
+		{name: "integer", validators: []govalidator.ContextValidator{govalidator.IsIntegerValidator}, want: `{"type": "integer"}`},
+		{name: "number", validators: []govalidator.ContextValidator{govalidator.NumberValidator}, want: `{"type": "number"}`},
+		{name: "boolean", validators: []govalidator.ContextValidator{govalidator.IsBooleanValidator}, want: `{"type": "boolean"}`},
+		{name: "list", validators: []govalidator.ContextValidator{govalidator.IsListValidator}, want: `{"type": "array"}`},
+		{name: "map", validators: []govalidator.ContextValidator{govalidator.IsMapValidator}, want: `{"type": "object"}`},
+		{name: "float precision", validators: []govalidator.ContextValidator{govalidator.FloatValidator(2)},
+			want: `{"type": "number", "multipleOf": 0.01}`},
+		{name: "integer narrows number", validators: []govalidator.ContextValidator{govalidator.NumberValidator, govalidator.IsIntegerValidator},
+			want: `{"type": "integer"}`},
+		{name: "lengths", validators: []govalidator.ContextValidator{govalidator.MinLengthValidator(3), govalidator.MaxLengthValidator(20)},
+			want: `{"minLength": 3, "maxLength": 20, "not": {"type": "null"}}`},
+		{name: "sizes", validators: []govalidator.ContextValidator{
+			govalidator.IsListValidator, govalidator.MinSizeValidator(1, false), govalidator.MaxSizeValidator(5, true),
+		}, want: `{"type": "array", "minItems": 1, "maxItems": 5}`},
+		{name: "bounds", validators: []govalidator.ContextValidator{
+			govalidator.NumberValidator, govalidator.MinFloatValidator(0.5), govalidator.MaxFloatValidator(10),
+		}, want: `{"type": "number", "minimum": 0.5, "maximum": 10}`},
+		{name: "enum", validators: []govalidator.ContextValidator{govalidator.OneOfValidator("admin", "user")},
+			want: `{"enum": ["admin", "user"]}`},
+		{name: "pattern", validators: []govalidator.ContextValidator{govalidator.IsStringValidator, govalidator.RegexpValidator(*regexp.MustCompile(`^[a-z]+$`))},
+			want: `{"type": "string", "pattern": "^[a-z]+$"}`},
+		{name: "formats", validators: []govalidator.ContextValidator{govalidator.IsStringValidator, govalidator.EmailValidator},
+			want: `{"type": "string", "format": "email"}`},
+		{name: "uuid", validators: []govalidator.ContextValidator{govalidator.IsStringValidator, govalidator.UUIDValidator},
+			want: `{"type": "string", "format": "uuid"}`},
+		{name: "url", validators: []govalidator.ContextValidator{govalidator.IsStringValidator, govalidator.URLValidator},
+			want: `{"type": "string", "format": "uri"}`},
+		{name: "ip addresses", validators: []govalidator.ContextValidator{govalidator.IPv4Validator, govalidator.IPv6Validator},
+			want: `{"format": "ipv4", "allOf": [{"format": "ipv6"}], "not": {"type": "null"}}`},
+		{name: "xid", validators: []govalidator.ContextValidator{govalidator.IsStringValidator, govalidator.XIDValidator},
+			want: `{"type": "string", "pattern": "^[0-9a-v]{20}$"}`},
+		{name: "content", validators: []govalidator.ContextValidator{govalidator.IsStringValidator, govalidator.Base64Validator, govalidator.JSONValidator},
+			want: `{"type": "string", "contentEncoding": "base64", "contentMediaType": "application/json"}`},
+		{name: "extensions", validators: []govalidator.ContextValidator{
+			govalidator.IsStringValidator, govalidator.LuhnValidator, govalidator.LowerCaseValidator, govalidator.UpperCaseValidator, noProfanity,
+		}, want: `{"type": "string", "x-validators": [
+			{"name": "luhn", "description": "must pass the Luhn checksum"},
+			{"name": "lower_case", "description": "must be lower case"},
+			{"name": "upper_case", "description": "must be upper case"},
+			{"name": "github.com/gstachniukrsk/govalidator_test.noProfanity"}
+		]}`},
+		{name: "nullability validators", validators: []govalidator.ContextValidator{
+			govalidator.IsStringValidator, govalidator.NullableValidator, govalidator.NonNullableValidator,
+		}, want: `{"type": "string"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := govalidator.NewSchema(tt.validators...).Required()
+
+			assert.JSONEq(t, tt.want, exportedJSON(t, schema))
+		})
+	}
+}
+
+func TestSchema_ToJSONSchema_Null(t *testing.T) {
+	t.Run("optional values accept null", func(t *testing.T) {
+		schema := govalidator.NewSchema(govalidator.IsStringValidator)
+
+		assert.JSONEq(t, `{"type": ["string", "null"]}`, exportedJSON(t, schema))
+	})
+
+	t.Run("optional enums include null", func(t *testing.T) {
+		schema := govalidator.NewSchema(govalidator.OneOfValidator("a", "b"))
+
+		assert.JSONEq(t, `{"enum": ["a", "b", null]}`, exportedJSON(t, schema))
+	})
+
+	t.Run("not null without a type", func(t *testing.T) {
+		schema := govalidator.NewSchema().NotNull()
+
+		assert.JSONEq(t, `{"not": {"type": "null"}}`, exportedJSON(t, schema))
+	})
+
+	t.Run("optional values with branches", func(t *testing.T) {
+		schema := govalidator.AnyOf(
+			govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+			govalidator.NewSchema(govalidator.IsIntegerValidator).Required(),
+		)
+
+		assert.JSONEq(t, `{"anyOf": [
+			{"type": "null"},
+			{"anyOf": [{"type": "string"}, {"type": "integer"}]}
+		]}`, exportedJSON(t, schema))
+	})
+}
+
+func TestSchema_ToJSONSchema_Objects(t *testing.T) {
+	t.Run("fields", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"name":     govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+			"nickname": govalidator.NewSchema(govalidator.IsStringValidator).Present(),
+			"age":      govalidator.NewSchema(govalidator.IsIntegerValidator),
+		}).WithExtra(govalidator.ExtraForbid).Required()
+
+		assert.JSONEq(t, `{
+			"type": "object",
+			"properties": {
+				"name": {"type": "string"},
+				"nickname": {"type": ["string", "null"]},
+				"age": {"type": ["integer", "null"]}
+			},
+			"required": ["name", "nickname"],
+			"additionalProperties": false
+		}`, exportedJSON(t, schema))
+	})
+
+	t.Run("imported keywords", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"type": "object",
+			"properties": {"code": {"minLength": 2}},
+			"required": ["code", "name"],
+			"additionalProperties": false
+		}`)
+
+		assert.JSONEq(t, `{
+			"type": "object",
+			"properties": {"code": {"minLength": 2}},
+			"required": ["code", "name"],
+			"additionalProperties": false
+		}`, exportedJSON(t, schema))
+	})
+
+	t.Run("imported dependent requirements", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"type": "object",
+			"properties": {"a": {}},
+			"dependentRequired": {"a": ["b"]},
+			"additionalProperties": false
+		}`)
+
+		assert.JSONEq(t, `{
+			"type": "object",
+			"properties": {"a": {}},
+			"additionalProperties": false,
+			"allOf": [{
+				"if": {"type": "object", "properties": {"a": {"not": {"type": "null"}}}, "required": ["a"]},
+				"then": {"type": "object", "properties": {"b": {"not": {"type": "null"}}}, "required": ["b"]}
+			}]
+		}`, exportedJSON(t, schema))
+	})
+
+	t.Run("values, pattern fields and keys", func(t *testing.T) {
+		schema := govalidator.NewSchema().
+			Values(govalidator.NewSchema(govalidator.IsIntegerValidator).Required()).
+			PatternField(regexp.MustCompile(`^x-`), govalidator.NewSchema(govalidator.IsStringValidator).Required()).
+			Keys(govalidator.MaxLengthValidator(10)).
+			Required()
+
+		assert.JSONEq(t, `{
+			"type": "object",
+			"additionalProperties": {"type": "integer"},
+			"patternProperties": {"^x-": {"type": "string"}},
+			"propertyNames": {"maxLength": 10}
+		}`, exportedJSON(t, schema))
+	})
+
+	t.Run("conditional requirements", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"cert":  govalidator.NewSchema(govalidator.IsStringValidator).RequiredIf("tls.enabled", true),
+			"cvv":   govalidator.NewSchema(govalidator.IsStringValidator).RequiredWith("card"),
+			"email": govalidator.NewSchema(govalidator.IsStringValidator).RequiredWithout("phone"),
+			"iban":  govalidator.NewSchema(govalidator.IsStringValidator).RequiredUnless("plan", "free"),
+			"token": govalidator.NewSchema(govalidator.IsStringValidator).MutuallyExclusive("password"),
+		}).Required()
+
+		doc := schema.ToJSONSchema()
+		present := func(field string) map[string]any {
+			return map[string]any{
+				"type":       "object",
+				"required":   []string{field},
+				"properties": map[string]any{field: map[string]any{"not": map[string]any{"type": "null"}}},
+			}
+		}
+
+		assert.Equal(t, []any{
+			map[string]any{
+				"if": map[string]any{
+					"type":     "object",
+					"required": []string{"tls"},
+					"properties": map[string]any{"tls": map[string]any{
+						"type":       "object",
+						"required":   []string{"enabled"},
+						"properties": map[string]any{"enabled": map[string]any{"const": true}},
+					}},
+				},
+				"then": present("cert"),
+			},
+			map[string]any{"if": present("card"), "then": present("cvv")},
+			map[string]any{"if": map[string]any{"not": present("phone")}, "then": present("email")},
+			map[string]any{
+				"if": map[string]any{"not": map[string]any{
+					"type":       "object",
+					"required":   []string{"plan"},
+					"properties": map[string]any{"plan": map[string]any{"const": "free"}},
+				}},
+				"then": present("iban"),
+			},
+			map[string]any{"if": present("token"), "then": map[string]any{"not": present("password")}},
+		}, doc["allOf"])
+	})
+
+	t.Run("discriminator", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"id": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		}).Discriminator("type", map[string]*govalidator.Schema{
+			"push": govalidator.Object(map[string]*govalidator.Schema{
+				"ref": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+			}).Required(),
+		}).WithExtra(govalidator.ExtraForbid).Required()
+
+		assert.JSONEq(t, `{
+			"type": "object",
+			"properties": {"id": {"type": "string"}, "type": {"enum": ["push"]}},
+			"required": ["id", "type"],
+			"allOf": [{
+				"if": {"properties": {"type": {"const": "push"}}, "required": ["type"]},
+				"then": {"type": "object", "properties": {"ref": {"type": "string"}}, "required": ["ref"]}
+			}],
+			"unevaluatedProperties": false
+		}`, exportedJSON(t, schema))
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{}).WithRules(
+			govalidator.EqualFieldsRule("password_confirm", "password"),
+			govalidator.LessThanFieldRule("min", "max"),
+			govalidator.GreaterThanFieldRule("end", "start"),
+			noSelfReview,
+		).Required()
+
+		assert.JSONEq(t, `{
+			"type": "object",
+			"x-rules": [
+				{
+					"name": "equal_fields",
+					"description": "password_confirm must equal password",
+					"params": {"field": "password_confirm", "other": "password"}
+				},
+				{"name": "less_than_field", "description": "min must be less than max", "params": {"field": "min", "other": "max"}},
+				{"name": "greater_than_field", "description": "end must be greater than start", "params": {"field": "end", "other": "start"}},
+				{"name": "github.com/gstachniukrsk/govalidator_test.noSelfReview"}
+			]
+		}`, exportedJSON(t, schema))
+	})
+}
+
+func TestSchema_ToJSONSchema_Arrays(t *testing.T) {
+	t.Run("items", func(t *testing.T) {
+		schema := govalidator.Array(govalidator.NewSchema(govalidator.IsStringValidator).Required()).Required()
+
+		assert.JSONEq(t, `{"type": "array", "items": {"type": "string"}}`, exportedJSON(t, schema))
+	})
+
+	t.Run("tuples", func(t *testing.T) {
+		schema := govalidator.Tuple(
+			govalidator.NewSchema(govalidator.NumberValidator).Required(),
+			govalidator.NewSchema(govalidator.NumberValidator),
+		).NoAdditionalItems().Required()
+
+		assert.JSONEq(t, `{
+			"type": "array",
+			"prefixItems": [{"type": "number"}, {"type": ["number", "null"]}],
+			"minItems": 1,
+			"items": false
+		}`, exportedJSON(t, schema))
+	})
+}
+
+func TestSchema_ToJSONSchema_Combinators(t *testing.T) {
+	str := govalidator.NewSchema(govalidator.IsStringValidator).Required()
+	integer := govalidator.NewSchema(govalidator.IsIntegerValidator).Required()
+
+	schema := govalidator.NewSchema().Required()
+	schema.If(str).Then(govalidator.NewSchema(govalidator.MinLengthValidator(1)).Required()).Else(integer)
+
+	assert.JSONEq(t, `{
+		"if": {"type": "string"},
+		"then": {"minLength": 1, "not": {"type": "null"}},
+		"else": {"type": "integer"},
+		"not": {"type": "null"}
+	}`, exportedJSON(t, schema))
+
+	assert.JSONEq(t, `{"allOf": [{"type": "string"}, {"type": "integer"}], "not": {"type": "null"}}`,
+		exportedJSON(t, govalidator.AllOf(str, integer).Required()))
+	assert.JSONEq(t, `{"oneOf": [{"type": "string"}, {"type": "integer"}], "not": {"type": "null"}}`,
+		exportedJSON(t, govalidator.ExactlyOneOf(str, integer).Required()))
+}
+
+func TestSchema_ToJSONSchema_Definitions(t *testing.T) {
+	t.Run("references", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"billing":  govalidator.Ref("address").Required(),
+			"shipping": govalidator.Ref("address"),
+		}).Define("address", govalidator.Object(map[string]*govalidator.Schema{
+			"city": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		}).Required()).Required()
+
+		assert.JSONEq(t, `{
+			"type": "object",
+			"properties": {
+				"billing": {"$ref": "#/$defs/address", "not": {"type": "null"}},
+				"shipping": {"$ref": "#/$defs/address"}
+			},
+			"required": ["billing"],
+			"$defs": {
+				"address": {"type": "object", "properties": {"city": {"type": "string"}}, "required": ["city"]}
+			}
+		}`, exportedJSON(t, schema))
+	})
+
+	t.Run("shadowed names", func(t *testing.T) {
+		inner := govalidator.Object(map[string]*govalidator.Schema{
+			"id": govalidator.Ref("id").Required(),
+		}).Define("id", govalidator.NewSchema(govalidator.IsIntegerValidator).Required()).Required()
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"id":    govalidator.Ref("id").Required(),
+			"inner": inner,
+		}).Define("id", govalidator.NewSchema(govalidator.IsStringValidator).Required()).Required()
+
+		doc := schema.ToJSONSchema()
+
+		properties := doc["properties"].(map[string]any)
+		innerProperties := properties["inner"].(map[string]any)["properties"].(map[string]any)
+		assert.Equal(t, "#/$defs/id", properties["id"].(map[string]any)["$ref"])
+		assert.Equal(t, "#/$defs/id_2", innerProperties["id"].(map[string]any)["$ref"])
+		assert.Equal(t, map[string]any{
+			"id":   map[string]any{"type": "string"},
+			"id_2": map[string]any{"type": "integer"},
+		}, doc["$defs"])
+	})
+
+	t.Run("recursive structs", func(t *testing.T) {
+		type Category struct {
+			Name     string      `json:"name"`
+			Children []*Category `json:"children,omitempty"`
+		}
+		schema, err := govalidator.SchemaFor[Category]()
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{
+			"$ref": "#/$defs/govalidator_test.Category",
+			"$defs": {
+				"govalidator_test.Category": {
+					"type": ["object", "null"],
+					"properties": {
+						"name": {"type": "string"},
+						"children": {
+							"type": ["array", "null"],
+							"items": {"$ref": "#/$defs/govalidator_test.Category"}
+						}
+					},
+					"required": ["name"]
+				}
+			}
+		}`, exportedJSON(t, schema))
+	})
+
+	t.Run("imported root references", func(t *testing.T) {
+		imported, err := govalidator.FromJSONSchema([]byte(`{
+			"type": "object",
+			"properties": {"next": {"$ref": "#"}, "tag": {"$ref": "#/$defs/tag"}},
+			"$defs": {"tag": {"type": "string"}}
+		}`))
+		require.NoError(t, err)
+
+		doc := imported.ToJSONSchema()
+
+		properties := doc["properties"].(map[string]any)
+		assert.Equal(t, map[string]any{"$ref": "#"}, properties["next"])
+		assert.Equal(t, map[string]any{"$ref": "#/$defs/tag"}, properties["tag"])
+		assert.Equal(t, map[string]any{"tag": map[string]any{"type": "string"}}, doc["$defs"])
+	})
+}
+
+func TestSchema_ToJSONSchema_Annotations(t *testing.T) {
+	t.Run("static defaults", func(t *testing.T) {
+		schema := govalidator.NewSchema(govalidator.IsStringValidator).Default("asc").Required()
+
+		assert.JSONEq(t, `{"type": "string", "default": "asc"}`, exportedJSON(t, schema))
+	})
+
+	t.Run("computed defaults are not evaluated", func(t *testing.T) {
+		schema := govalidator.NewSchema(govalidator.IsStringValidator).DefaultFunc(func(context.Context) any {
+			return time.Now().String()
+		}).Required()
+
+		assert.JSONEq(t, `{"type": "string"}`, exportedJSON(t, schema))
+	})
+
+	t.Run("transformers", func(t *testing.T) {
+		schema := govalidator.NewSchema(govalidator.IsIntegerValidator).
+			WithTransformers(govalidator.TrimSpaceTransformer, govalidator.StringToIntTransformer).
+			Required()
+
+		assert.JSONEq(t, `{"type": ["integer", "string"], "x-transformers": ["trim_space", "string_to_int"]}`,
+			exportedJSON(t, schema))
+	})
+
+	t.Run("times", func(t *testing.T) {
+		type Event struct {
+			At time.Time `json:"at"`
+		}
+		schema, err := govalidator.SchemaFor[Event]()
+		require.NoError(t, err)
+
+		doc := schema.ToJSONSchema()
+
+		assert.Equal(t, map[string]any{
+			"type":           "string",
+			"format":         "date-time",
+			"x-transformers": []any{"rfc3339_to_time"},
+		}, doc["properties"].(map[string]any)["at"])
+	})
+}
+
+func TestSchema_ToJSONSchema_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	schema := govalidator.Object(map[string]*govalidator.Schema{
+		"name":  govalidator.NewSchema(govalidator.IsStringValidator, govalidator.MinLengthValidator(2)).Required(),
+		"email": govalidator.NewSchema(govalidator.IsStringValidator, govalidator.EmailValidator),
+		"role":  govalidator.NewSchema(govalidator.OneOfValidator("admin", "user")).Default("user"),
+		"tags":  govalidator.Array(govalidator.NewSchema(govalidator.IsStringValidator).Required()),
+	}).WithExtra(govalidator.ExtraForbid).Required()
+
+	data, err := json.Marshal(schema.ToJSONSchema())
+	require.NoError(t, err)
+	imported, err := govalidator.FromJSONSchema(data)
+	require.NoError(t, err)
+
+	for _, value := range []any{
+		map[string]any{"name": "Ann", "email": "ann@example.com", "role": "admin", "tags": []any{"a"}},
+		map[string]any{"name": "Ann", "email": nil, "role": nil, "tags": nil},
+		map[string]any{"name": "A", "email": "ann", "role": "root", "tags": []any{1.0}, "other": true},
+		map[string]any{},
+		nil,
+	} {
+		wantValid, wantErrs := schema.Validate(ctx, value)
+		gotValid, gotErrs := imported.Validate(ctx, value)
+
+		assert.Equal(t, wantValid, gotValid, value)
+		assert.ElementsMatch(t, keys(wantErrs), keys(gotErrs), value)
+	}
+}
@@ -0,0 +1,378 @@
+package govalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustFromJSONSchema(t *testing.T, doc string) *govalidator.Schema {
+	t.Helper()
+	schema, err := govalidator.FromJSONSchema([]byte(doc))
+	require.NoError(t, err)
+	return schema
+}
+
+func TestFromJSONSchema(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("objects", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"title": "User",
+			"type": "object",
+			"properties": {
+				"id": {"type": "string", "format": "uuid"},
+				"name": {"type": "string", "minLength": 2, "maxLength": 5, "description": "display name"},
+				"email": {"type": "string", "format": "email"},
+				"age": {"type": "integer", "minimum": 0, "maximum": 150},
+				"role": {"enum": ["admin", "user"]},
+				"nickname": {"type": ["string", "null"]},
+				"code": {"type": "string", "pattern": "^[A-Z]{3}$"}
+			},
+			"required": ["id", "name", "nickname"],
+			"additionalProperties": false
+		}`)
+
+		valid, errs := schema.Validate(ctx, map[string]any{
+			"id": "550e8400-e29b-41d4-a716-446655440000", "name": "Ann", "email": "ann@example.com",
+			"age": 30.0, "role": "admin", "nickname": nil, "code": "ABC",
+		})
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+
+		_, errs = schema.Validate(ctx, map[string]any{
+			"id": "x", "name": "A", "email": "ann", "age": 200.0, "role": "root", "code": "abc", "other": 1,
+		})
+		assert.ElementsMatch(t, []string{"$", "$.id", "$.name", "$.email", "$.age", "$.role", "$.nickname", "$.code"}, keys(errs))
+		assert.Equal(t, []string{"field nickname is missing"}, errs["$.nickname"])
+		assert.Equal(t, []string{"unexpected field other"}, errs["$"])
+	})
+
+	t.Run("null is rejected unless allowed", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{"type": "object", "properties": {"a": {"type": "string"}, "b": {}}}`)
+
+		_, errs := schema.Validate(ctx, map[string]any{"a": nil, "b": nil})
+		assert.Equal(t, map[string][]string{"$.a": {"null is not allowed"}}, errs)
+
+		valid, _ := schema.Validate(ctx, map[string]any{})
+		assert.True(t, valid)
+
+		_, errs = schema.Validate(ctx, nil)
+		assert.Equal(t, map[string][]string{"$": {"null is not allowed"}}, errs)
+
+		nullOnly := mustFromJSONSchema(t, `{"type": "null"}`)
+		valid, _ = nullOnly.Validate(ctx, nil)
+		assert.True(t, valid)
+		valid, _ = nullOnly.Validate(ctx, 1.0)
+		assert.False(t, valid)
+	})
+
+	t.Run("multiple types", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{"type": ["string", "integer"]}`)
+
+		valid, _ := schema.Validate(ctx, "a")
+		assert.True(t, valid)
+		valid, _ = schema.Validate(ctx, 1.0)
+		assert.True(t, valid)
+		valid, _ = schema.Validate(ctx, true)
+		assert.False(t, valid)
+	})
+
+	t.Run("additional and pattern properties", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"type": "object",
+			"patternProperties": {"^x-": {"type": "string"}},
+			"additionalProperties": {"type": "integer"}
+		}`)
+
+		valid, _ := schema.Validate(ctx, map[string]any{"x-a": "s", "b": 1.0})
+		assert.True(t, valid)
+
+		_, errs := schema.Validate(ctx, map[string]any{"x-a": 1.0, "b": "s"})
+		assert.Equal(t, map[string][]string{"$.x-a": {"not a string"}, "$.b": {"not an integer"}}, errs)
+
+		open := mustFromJSONSchema(t, `{"properties": {"a": true}, "additionalProperties": true}`)
+		valid, _ = open.Validate(ctx, map[string]any{"b": 1.0})
+		assert.True(t, valid)
+	})
+
+	t.Run("arrays", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 2}`)
+
+		valid, _ := schema.Validate(ctx, []any{"a"})
+		assert.True(t, valid)
+
+		_, errs := schema.Validate(ctx, []any{"a", 1.0, "c"})
+		assert.Equal(t, map[string][]string{"$": {"max size 2, actual size 3"}, "$[1]": {"not a string"}}, errs)
+
+		tuple := mustFromJSONSchema(t, `{"prefixItems": [{"type": "number"}, {"type": "string"}], "items": false}`)
+		valid, _ = tuple.Validate(ctx, []any{1.0, "a"})
+		assert.True(t, valid)
+		_, errs = tuple.Validate(ctx, []any{1.0, "a", true})
+		assert.Contains(t, errs, "$")
+
+		rest := mustFromJSONSchema(t, `{"prefixItems": [{"type": "number"}], "items": {"type": "boolean"}}`)
+		_, errs = rest.Validate(ctx, []any{1.0, true, "x"})
+		assert.Equal(t, map[string][]string{"$[2]": {"not a boolean"}}, errs)
+
+		empty := mustFromJSONSchema(t, `{"type": "array", "items": false}`)
+		valid, _ = empty.Validate(ctx, []any{1.0})
+		assert.False(t, valid)
+	})
+
+	t.Run("const", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{"const": 3}`)
+
+		valid, _ := schema.Validate(ctx, 3.0)
+		assert.True(t, valid)
+		valid, _ = schema.Validate(ctx, 4.0)
+		assert.False(t, valid)
+	})
+
+	t.Run("formats", func(t *testing.T) {
+		for format, value := range map[string]string{
+			"uri":  "https://example.com",
+			"ipv4": "127.0.0.1",
+			"ipv6": "::1",
+		} {
+			schema := mustFromJSONSchema(t, `{"type": "string", "format": "`+format+`"}`)
+			valid, errs := schema.Validate(ctx, value)
+			assert.True(t, valid, format, errs)
+		}
+	})
+
+	t.Run("refs and defs", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"$defs": {
+				"node": {
+					"type": "object",
+					"properties": {
+						"name": {"type": "string"},
+						"children": {"type": "array", "items": {"$ref": "#/$defs/node"}}
+					},
+					"required": ["name"]
+				}
+			},
+			"$ref": "#/$defs/node"
+		}`)
+
+		valid, _ := schema.Validate(ctx, map[string]any{"name": "root", "children": []any{map[string]any{"name": "leaf"}}})
+		assert.True(t, valid)
+
+		_, errs := schema.Validate(ctx, map[string]any{"name": "root", "children": []any{map[string]any{}}})
+		assert.Equal(t, map[string][]string{"$.children[0].name": {"field name is missing"}}, errs)
+	})
+
+	t.Run("keywords of other types", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			doc     string
+			value   any
+			wantErr bool
+		}{
+			{name: "length of a number", doc: `{"type": ["string", "integer"], "minLength": 2}`, value: 5},
+			{name: "length of a short string", doc: `{"type": ["string", "integer"], "minLength": 2}`, value: "a", wantErr: true},
+			{name: "pattern without type", doc: `{"pattern": "^x"}`, value: true},
+			{name: "minimum of a string", doc: `{"minimum": 3}`, value: "abc"},
+			{name: "minimum of a small integer", doc: `{"minimum": 3}`, value: 2, wantErr: true},
+			{name: "size of an object", doc: `{"maxItems": 1}`, value: map[string]any{"a": 1, "b": 2}},
+			{name: "size of a list", doc: `{"maxItems": 1}`, value: []any{1, 2}, wantErr: true},
+			{name: "closed items of a string", doc: `{"items": false}`, value: "abc"},
+			{name: "closed items of a list", doc: `{"items": false}`, value: []any{1}, wantErr: true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				valid, errs := mustFromJSONSchema(t, tt.doc).Validate(ctx, tt.value)
+
+				assert.Equal(t, !tt.wantErr, valid, errs)
+			})
+		}
+	})
+
+	t.Run("required names without properties", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"type": "object",
+			"properties": {"id": {"type": "string"}},
+			"required": ["id", "name"],
+			"additionalProperties": false
+		}`)
+
+		assert.NotContains(t, schema.Fields, "name")
+
+		_, errs := schema.Validate(ctx, map[string]any{"id": "1"})
+		assert.Equal(t, map[string][]string{"$.name": {"field name is missing"}}, errs)
+
+		_, errs = schema.Validate(ctx, map[string]any{"id": "1", "name": "Ann"})
+		assert.Equal(t, map[string][]string{"$": {"unexpected field name"}}, errs)
+	})
+
+	t.Run("dependent required names without properties", func(t *testing.T) {
+		closed := mustFromJSONSchema(t, `{
+			"type": "object",
+			"properties": {"a": {"type": "integer"}},
+			"dependentRequired": {"a": ["b"]},
+			"additionalProperties": false
+		}`)
+
+		assert.NotContains(t, closed.Fields, "b")
+
+		_, errs := closed.Validate(ctx, map[string]any{"a": 1})
+		assert.Equal(t, map[string][]string{"$.b": {"required when a is present"}}, errs)
+
+		_, errs = closed.Validate(ctx, map[string]any{"a": 1, "b": 2})
+		assert.Equal(t, map[string][]string{"$": {"unexpected field b"}}, errs)
+
+		typed := mustFromJSONSchema(t, `{
+			"type": "object",
+			"properties": {"a": {"type": "integer"}},
+			"dependentRequired": {"a": ["b"]},
+			"additionalProperties": {"type": "string"}
+		}`)
+
+		valid, _ := typed.Validate(ctx, map[string]any{"a": 1, "b": "x"})
+		assert.True(t, valid)
+
+		_, errs = typed.Validate(ctx, map[string]any{"a": 1, "b": 2})
+		assert.Equal(t, map[string][]string{"$.b": {"not a string"}}, errs)
+	})
+
+	t.Run("refs with siblings", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"$defs": {"name": {"type": "string", "minLength": 2}},
+			"type": "object",
+			"properties": {
+				"first": {"$ref": "#/$defs/name", "maxLength": 3},
+				"optional": {"$ref": "#/$defs/name"}
+			}
+		}`)
+
+		valid, _ := schema.Validate(ctx, map[string]any{"first": "Ann"})
+		assert.True(t, valid)
+
+		_, errs := schema.Validate(ctx, map[string]any{"first": "Annabel"})
+		assert.Equal(t, map[string][]string{"$.first": {"expected at most 3 characters, got 7"}}, errs)
+
+		_, errs = schema.Validate(ctx, map[string]any{"first": "A"})
+		assert.Contains(t, errs, "$.first")
+	})
+
+	t.Run("recursive root", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{"type": "object", "properties": {"next": {"$ref": "#"}, "v": {"type": "integer"}}}`)
+
+		_, errs := schema.Validate(ctx, map[string]any{"next": map[string]any{"next": map[string]any{"v": "x"}}})
+
+		assert.Equal(t, map[string][]string{"$.next.next.v": {"not an integer"}}, errs)
+	})
+
+	t.Run("combinators", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"allOf": [{"type": "string"}, {"minLength": 2}],
+			"anyOf": [{"maxLength": 3}, {"pattern": "^x"}],
+			"oneOf": [{"const": "ab"}, {"const": "xyzw"}, {"const": "abc"}]
+		}`)
+
+		valid, _ := schema.Validate(ctx, "ab")
+		assert.True(t, valid)
+		valid, _ = schema.Validate(ctx, "xyzw")
+		assert.True(t, valid)
+		valid, _ = schema.Validate(ctx, "abcd")
+		assert.False(t, valid)
+	})
+
+	t.Run("if then else", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{
+			"type": "object",
+			"if": {"properties": {"kind": {"const": "card"}}},
+			"then": {"required": ["number"]},
+			"else": {"required": ["iban"]}
+		}`)
+
+		_, errs := schema.Validate(ctx, map[string]any{"kind": "card"})
+		assert.Equal(t, map[string][]string{"$.number": {"field number is missing"}}, errs)
+
+		_, errs = schema.Validate(ctx, map[string]any{"kind": "sepa"})
+		assert.Equal(t, map[string][]string{"$.iban": {"field iban is missing"}}, errs)
+	})
+
+	t.Run("dependent required", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{"dependentRequired": {"card": ["cvv"]}}`)
+
+		_, errs := schema.Validate(ctx, map[string]any{"card": "4111"})
+		assert.Equal(t, map[string][]string{"$.cvv": {"required when card is present"}}, errs)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		schema := mustFromJSONSchema(t, `{"type": "object", "properties": {"order": {"type": "string", "default": "asc"}}}`)
+
+		normalized, valid, _ := schema.Normalize(ctx, map[string]any{})
+
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"order": "asc"}, normalized)
+	})
+}
+
+func TestFromJSONSchema_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{name: "invalid JSON", doc: `{`, want: "invalid JSON Schema document: unexpected end of JSON input"},
+		{name: "unsupported keyword", doc: `{"properties": {"a": {"multipleOf": 2}}}`,
+			want: `unsupported JSON Schema keyword "multipleOf" at #/properties/a`},
+		{name: "unknown type", doc: `{"type": "decimal"}`, want: `invalid JSON Schema keyword "type" at #/type: unknown type "decimal"`},
+		{name: "malformed type", doc: `{"type": 1}`, want: `invalid JSON Schema keyword "type" at #/type: expected a string or an array of strings`},
+		{name: "malformed type list", doc: `{"type": [1]}`, want: `invalid JSON Schema keyword "type" at #/type: expected a string or an array of strings`},
+		{name: "unsupported format", doc: `{"format": "date-time"}`, want: `invalid JSON Schema keyword "format" at #/format: unsupported format "date-time"`},
+		{name: "bad pattern", doc: `{"pattern": "("}`, want: "invalid JSON Schema keyword \"pattern\" at #/pattern: error parsing regexp: missing closing ): `(`"},
+		{name: "non-string pattern", doc: `{"pattern": 1}`, want: `invalid JSON Schema keyword "pattern" at #/pattern: expected a string`},
+		{name: "bad pattern property", doc: `{"patternProperties": {"(": {}}}`, want: "invalid JSON Schema keyword \"patternProperties\" at #/patternProperties: error parsing regexp: missing closing ): `(`"},
+		{name: "malformed pattern properties", doc: `{"patternProperties": []}`, want: `invalid JSON Schema keyword "patternProperties" at #/patternProperties: expected an object`},
+		{name: "bad pattern property schema", doc: `{"patternProperties": {"a": 1}}`, want: `invalid JSON Schema keyword "schema" at #/patternProperties/a: expected an object or a boolean`},
+		{name: "negative length", doc: `{"minLength": -1}`, want: `invalid JSON Schema keyword "minLength" at #/minLength: expected a non-negative integer`},
+		{name: "non-number minimum", doc: `{"minimum": "1"}`, want: `invalid JSON Schema keyword "minimum" at #/minimum: expected a number`},
+		{name: "external ref", doc: `{"$ref": "other.json#/a"}`, want: `invalid JSON Schema keyword "$ref" at #/$ref: only local references to $defs are supported, got "other.json#/a"`},
+		{name: "non-string ref", doc: `{"$ref": 1}`, want: `invalid JSON Schema keyword "$ref" at #/$ref: expected a string`},
+		{name: "malformed defs", doc: `{"$defs": []}`, want: `invalid JSON Schema keyword "$defs" at #/$defs: expected an object`},
+		{name: "bad def", doc: `{"$defs": {"a": {"not": {}}}}`, want: `unsupported JSON Schema keyword "not" at #/$defs/a`},
+		{name: "false schema", doc: `{"properties": {"a": false}}`, want: `invalid JSON Schema keyword "false" at #/properties/a: false schemas are only supported in additionalProperties and items`},
+		{name: "non-object schema", doc: `[]`, want: `invalid JSON Schema keyword "schema" at #: expected an object or a boolean`},
+		{name: "malformed properties", doc: `{"properties": []}`, want: `invalid JSON Schema keyword "properties" at #/properties: expected an object`},
+		{name: "malformed required", doc: `{"required": "a"}`, want: `invalid JSON Schema keyword "required" at #/required: expected an array of strings`},
+		{name: "malformed required item", doc: `{"required": [1]}`, want: `invalid JSON Schema keyword "required" at #/required: expected an array of strings`},
+		{name: "malformed dependent required", doc: `{"dependentRequired": []}`, want: `invalid JSON Schema keyword "dependentRequired" at #/dependentRequired: expected an object`},
+		{name: "malformed dependent list", doc: `{"dependentRequired": {"a": 1}}`, want: `invalid JSON Schema keyword "dependentRequired" at #/dependentRequired/a: expected an array of strings`},
+		{name: "bad additional properties", doc: `{"additionalProperties": 1}`, want: `invalid JSON Schema keyword "schema" at #/additionalProperties: expected an object or a boolean`},
+		{name: "bad items", doc: `{"items": 1}`, want: `invalid JSON Schema keyword "schema" at #/items: expected an object or a boolean`},
+		{name: "malformed prefix items", doc: `{"prefixItems": {}}`, want: `invalid JSON Schema keyword "prefixItems" at #/prefixItems: expected an array`},
+		{name: "bad prefix item", doc: `{"prefixItems": [1]}`, want: `invalid JSON Schema keyword "schema" at #/prefixItems/0: expected an object or a boolean`},
+		{name: "malformed enum", doc: `{"enum": 1}`, want: `invalid JSON Schema keyword "enum" at #/enum: expected an array`},
+		{name: "empty any of", doc: `{"anyOf": []}`, want: `invalid JSON Schema keyword "anyOf" at #/anyOf: expected a non-empty array`},
+		{name: "bad branch", doc: `{"allOf": [1]}`, want: `invalid JSON Schema keyword "schema" at #/allOf/0: expected an object or a boolean`},
+		{name: "bad if", doc: `{"if": 1}`, want: `invalid JSON Schema keyword "schema" at #/if: expected an object or a boolean`},
+		{name: "bad ref target sibling", doc: `{"$ref": "#/$defs/a", "minimum": "x"}`, want: `invalid JSON Schema keyword "minimum" at #/minimum: expected a number`},
+		{name: "bad property", doc: `{"properties": {"a": {"type": "x"}}}`, want: `invalid JSON Schema keyword "type" at #/properties/a/type: unknown type "x"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := govalidator.FromJSONSchema([]byte(tt.doc))
+
+			assert.Nil(t, schema)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+
+	t.Run("error types", func(t *testing.T) {
+		_, err := govalidator.FromJSONSchema([]byte(`{"not": {}}`))
+		assert.Equal(t, govalidator.UnsupportedKeywordError{Path: "#", Keyword: "not"}, err)
+
+		_, err = govalidator.FromJSONSchema([]byte(`{"minimum": "1"}`))
+		assert.Equal(t, govalidator.InvalidKeywordError{Path: "#/minimum", Keyword: "minimum", Reason: "expected a number"}, err)
+	})
+}
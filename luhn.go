@@ -0,0 +1,52 @@
+package govalidator
+
+import "context"
+
+// InvalidLuhnChecksumError is returned when a number fails the Luhn checksum.
+type InvalidLuhnChecksumError struct {
+	Value string
+}
+
+// Error returns the error message.
+func (e InvalidLuhnChecksumError) Error() string {
+	return "invalid Luhn checksum"
+}
+
+// LuhnValidator validates that a string of digits passes the Luhn checksum,
+// as used by payment card numbers and IMEI codes.
+// Example: "4111111111111111"
+func LuhnValidator(_ context.Context, value any) (twigBlock bool, errs []error) {
+	str, ok := value.(string)
+	if !ok {
+		return true, []error{NotAStringError{}}
+	}
+
+	if len(str) < 2 {
+		return false, []error{InvalidLuhnChecksumError{Value: str}}
+	}
+
+	sum := 0
+	double := false
+	for i := len(str) - 1; i >= 0; i-- {
+		c := str[i]
+		if c < '0' || c > '9' {
+			return false, []error{InvalidLuhnChecksumError{Value: str}}
+		}
+
+		digit := int(c - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+
+	if sum%10 != 0 {
+		return false, []error{InvalidLuhnChecksumError{Value: str}}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,116 @@
+package govalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLuhnValidator(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		value any
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantTwigBlock bool
+		wantErrs      []error
+	}{
+		{
+			name: "valid card number",
+			args: args{
+				ctx:   context.Background(),
+				value: "4111111111111111",
+			},
+			wantTwigBlock: false,
+			wantErrs:      nil,
+		},
+		{
+			name: "valid card number with odd length",
+			args: args{
+				ctx:   context.Background(),
+				value: "378282246310005",
+			},
+			wantTwigBlock: false,
+			wantErrs:      nil,
+		},
+		{
+			name: "valid IMEI",
+			args: args{
+				ctx:   context.Background(),
+				value: "490154203237518",
+			},
+			wantTwigBlock: false,
+			wantErrs:      nil,
+		},
+		{
+			name: "invalid check digit",
+			args: args{
+				ctx:   context.Background(),
+				value: "4111111111111112",
+			},
+			wantTwigBlock: false,
+			wantErrs: []error{
+				govalidator.InvalidLuhnChecksumError{Value: "4111111111111112"},
+			},
+		},
+		{
+			name: "contains spaces",
+			args: args{
+				ctx:   context.Background(),
+				value: "4111 1111 1111 1111",
+			},
+			wantTwigBlock: false,
+			wantErrs: []error{
+				govalidator.InvalidLuhnChecksumError{Value: "4111 1111 1111 1111"},
+			},
+		},
+		{
+			name: "too short",
+			args: args{
+				ctx:   context.Background(),
+				value: "0",
+			},
+			wantTwigBlock: false,
+			wantErrs: []error{
+				govalidator.InvalidLuhnChecksumError{Value: "0"},
+			},
+		},
+		{
+			name: "not a string - integer",
+			args: args{
+				ctx:   context.Background(),
+				value: 4111111111111111,
+			},
+			wantTwigBlock: true,
+			wantErrs: []error{
+				govalidator.NotAStringError{},
+			},
+		},
+		{
+			name: "not a string - nil",
+			args: args{
+				ctx:   context.Background(),
+				value: nil,
+			},
+			wantTwigBlock: true,
+			wantErrs: []error{
+				govalidator.NotAStringError{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotTwigBlock, gotErrs := govalidator.LuhnValidator(tt.args.ctx, tt.args.value)
+			assert.Equal(t, tt.wantTwigBlock, gotTwigBlock, "LuhnValidator(%v, %v)", tt.args.ctx, tt.args.value)
+			assert.Equal(t, tt.wantErrs, gotErrs, "LuhnValidator(%v, %v)", tt.args.ctx, tt.args.value)
+		})
+	}
+}
+
+func TestInvalidLuhnChecksumError(t *testing.T) {
+	assert.EqualError(t, govalidator.InvalidLuhnChecksumError{Value: "1"}, "invalid Luhn checksum")
+}
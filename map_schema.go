@@ -0,0 +1,94 @@
+package govalidator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// InvalidKeyError is returned when an object key is rejected by a key validator.
+// It is reported at the path of the key, like errors of the key's value, and
+// wraps the validator's error so key problems can be told apart from value problems.
+type InvalidKeyError struct {
+	Key string
+	Err error
+}
+
+// Error returns the error message.
+func (e InvalidKeyError) Error() string {
+	return fmt.Sprintf("invalid key %q: %s", e.Key, e.Err.Error())
+}
+
+// Unwrap returns the underlying error.
+func (e InvalidKeyError) Unwrap() error {
+	return e.Err
+}
+
+// Values sets the schema applied to the values of keys not listed in Fields.
+// This describes open-ended objects such as labels, metadata or per-locale translations.
+// Keys matched by the value schema are never reported as unexpected.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	labels := NewSchema().Values(NewSchema(IsStringValidator, MaxLengthValidator(63)).Required())
+//	// {"env": 1} -> $.env: not a string
+func (s *Schema) Values(valueSchema *Schema) *Schema {
+	s.values = valueSchema
+	return s
+}
+
+// Keys sets validators applied to every key name of the object.
+// Key errors are reported at the key's path wrapped in an InvalidKeyError.
+// If a key is rejected, its value is not validated.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	labels := NewSchema().
+//	    Keys(LowerCaseValidator, MaxLengthValidator(63)).
+//	    Values(NewSchema(IsStringValidator))
+func (s *Schema) Keys(validators ...ContextValidator) *Schema {
+	s.keys = validators
+	return s
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// validateKeys runs the key validators against every key of the object
+// and returns the keys that were rejected.
+func (sv *SchemaValidator) validateKeys(
+	valCtx *ValidationContext,
+	currentMap map[string]any,
+	schema *Schema,
+) map[string]bool {
+	if len(schema.keys) == 0 {
+		return nil
+	}
+
+	rejected := make(map[string]bool)
+	for _, key := range sortedKeys(currentMap) {
+		childCtx := sv.pushPath(valCtx, key)
+		for _, validator := range schema.keys {
+			shouldBlock, errs := validator(valCtx.ctx, key)
+
+			for _, err := range errs {
+				rejected[key] = true
+				childCtx.errorCollector.Collect(childCtx.path, InvalidKeyError{Key: key, Err: err})
+			}
+
+			if shouldBlock {
+				rejected[key] = true
+				break
+			}
+		}
+	}
+	return rejected
+}
@@ -0,0 +1,151 @@
+package govalidator_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_Values(t *testing.T) {
+	schema := govalidator.Object(map[string]*govalidator.Schema{
+		"labels": govalidator.NewSchema(govalidator.IsMapValidator).Values(
+			govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		),
+	})
+
+	t.Run("accepts valid values", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"labels": map[string]any{"env": "prod", "team": "core"},
+		})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("reports value errors at key path", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"labels": map[string]any{"env": "prod", "replicas": 3, "owner": nil},
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$.labels.replicas": {"not a string"},
+			"$.labels.owner":    {"required"},
+		}, errs)
+	})
+
+	t.Run("declared fields take precedence over value schema", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"count": govalidator.NewSchema(govalidator.IsIntegerValidator).Required(),
+		}).Values(govalidator.NewSchema(govalidator.IsStringValidator)).WithExtra(govalidator.ExtraForbid)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"count": 3,
+			"name":  "x",
+		})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("rejects non-map values", func(t *testing.T) {
+		schema := govalidator.NewSchema().Values(govalidator.NewSchema(govalidator.IsStringValidator))
+
+		valid, errs := schema.Validate(context.Background(), []any{"a"})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"not a map"}}, errs)
+	})
+}
+
+func TestSchema_Keys(t *testing.T) {
+	schema := govalidator.NewSchema().
+		Keys(govalidator.LowerCaseValidator, govalidator.MaxLengthValidator(5)).
+		Values(govalidator.NewSchema(govalidator.IsStringValidator))
+
+	t.Run("accepts valid keys", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{"en": "Hello"})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("reports key errors at key path", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"EN":        1,
+			"toolong":   "x",
+			"ok":        2,
+			"fine_text": "x",
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$.EN":        {`invalid key "EN": "EN" is not lower cased`},
+			"$.toolong":   {`invalid key "toolong": expected at most 5 characters, got 7`},
+			"$.fine_text": {`invalid key "fine_text": expected at most 5 characters, got 9`},
+			"$.ok":        {"not a string"},
+		}, errs)
+	})
+
+	t.Run("key errors are distinguishable from value errors", func(t *testing.T) {
+		var keyErrs, valueErrs int
+		presenter := func(_ context.Context, _ []string, err error) string {
+			var keyErr govalidator.InvalidKeyError
+			if errors.As(err, &keyErr) {
+				keyErrs++
+			} else {
+				valueErrs++
+			}
+			return err.Error()
+		}
+
+		schema.ValidateWithPresenter(context.Background(), map[string]any{"EN": 1, "de": 2}, govalidator.PathPresenter("."), presenter)
+
+		assert.Equal(t, 1, keyErrs)
+		assert.Equal(t, 1, valueErrs)
+	})
+
+	t.Run("blocking key validator stops further key checks", func(t *testing.T) {
+		schema := govalidator.NewSchema().Keys(
+			govalidator.RegexpValidator(*regexp.MustCompile(`^x-`)),
+			func(_ context.Context, _ any) (bool, []error) {
+				return true, nil
+			},
+			govalidator.UpperCaseValidator,
+		)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"x-a": 1})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("keys apply to declared fields", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"Name": govalidator.NewSchema(govalidator.IsStringValidator),
+		}).Keys(govalidator.LowerCaseValidator)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"Name": "x"})
+
+		assert.False(t, valid)
+		assert.Contains(t, errs, "$.Name")
+	})
+}
+
+func TestInvalidKeyError(t *testing.T) {
+	err := govalidator.InvalidKeyError{Key: "Env", Err: govalidator.NotLowerCasedError{Input: "Env"}}
+	ctx := context.Background()
+
+	require.ErrorIs(t, err, govalidator.NotLowerCasedError{Input: "Env"})
+	assert.EqualError(t, err, `invalid key "Env": "Env" is not lower cased`)
+	assert.Equal(t, "key 'Env' is invalid: text must be at most 3 character(s) long",
+		govalidator.DetailedErrorPresenter()(ctx, nil, govalidator.InvalidKeyError{Key: "Env", Err: govalidator.StringTooLongError{MaxLength: 3}}))
+	assert.JSONEq(t,
+		`{"path":"$.Env","message":"invalid key \"Env\": \"Env\" is not lower cased","type":"InvalidKeyError","key":"Env","keyErrorType":"Error"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "Env"}, err))
+}
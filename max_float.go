@@ -17,13 +17,17 @@ func (e FloatTooLargeError) Error() string {
 // MaxFloatValidator is a validator that checks if the value is a float and is less than or equal to the max.
 func MaxFloatValidator(maxFloat float64) ContextValidator {
 	err := FloatTooLargeError{MaxFloat: maxFloat}
-	return func(ctx context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(ctx context.Context, value any) (twigBlock bool, errs []error) {
 		// get number
 		floatValue, floatOk := value.(float64)
 		intValue, intOk := value.(int)
 
 		if !floatOk && !intOk {
-			return true, []error{NotAFloatError{}}
+			native, ok := nativeNumber(value)
+			if !ok {
+				return true, []error{NotAFloatError{}}
+			}
+			floatValue, floatOk = native, true
 		}
 
 		if !floatOk && intOk {
@@ -35,5 +39,5 @@ func MaxFloatValidator(maxFloat float64) ContextValidator {
 		}
 
 		return
-	}
+	}, builtinInfo("max_float", maxFloat))
 }
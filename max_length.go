@@ -18,7 +18,7 @@ func (e StringTooLongError) Error() string {
 
 // MaxLengthValidator is a validator that checks if the value is a string and is less than or equal to the max.
 func MaxLengthValidator(maxLength int) ContextValidator {
-	return func(_ context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(_ context.Context, value any) (twigBlock bool, errs []error) {
 		switch v := value.(type) {
 		case string:
 			return handleUtf8(v, maxLength)
@@ -30,7 +30,7 @@ func MaxLengthValidator(maxLength int) ContextValidator {
 		}
 
 		return true, []error{NotAStringError{}}
-	}
+	}, builtinInfo("max_length", maxLength))
 }
 
 func handleUtf8(str string, maxLength int) (twig bool, errs []error) {
@@ -4,7 +4,7 @@ import "context"
 
 // MaxSizeValidator is a validator that checks if the value is a string or pointer of a string and if it's length is less than or equal to the given max size.
 func MaxSizeValidator(maxSize int, blocks bool) ContextValidator {
-	return func(_ context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(_ context.Context, value any) (twigBlock bool, errs []error) {
 		if value == nil {
 			return true, []error{NotAListError{}}
 		}
@@ -34,7 +34,19 @@ func MaxSizeValidator(maxSize int, blocks bool) ContextValidator {
 					ActualSize: len(*v),
 				})
 			}
+		default:
+			size, ok := nativeListLen(value)
+			if !ok {
+				return true, []error{NotAListError{}}
+			}
+			if size > maxSize {
+				twigBlock = blocks
+				errs = append(errs, MaxSizeError{
+					MaxSize:    maxSize,
+					ActualSize: size,
+				})
+			}
 		}
 		return
-	}
+	}, builtinInfo("max_size", maxSize, blocks))
 }
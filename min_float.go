@@ -16,12 +16,16 @@ func (e FloatTooSmallError) Error() string {
 
 // MinFloatValidator is a validator that checks if the value is a float and is greater than or equal to the min.
 func MinFloatValidator(minFloat float64) ContextValidator {
-	return func(ctx context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(ctx context.Context, value any) (twigBlock bool, errs []error) {
 		floatValue, floatOk := value.(float64)
 		intValue, intOk := value.(int)
 
 		if !floatOk && !intOk {
-			return true, []error{NotAFloatError{}}
+			native, ok := nativeNumber(value)
+			if !ok {
+				return true, []error{NotAFloatError{}}
+			}
+			floatValue, floatOk = native, true
 		}
 
 		if !floatOk && intOk {
@@ -33,5 +37,5 @@ func MinFloatValidator(minFloat float64) ContextValidator {
 		}
 
 		return
-	}
+	}, builtinInfo("min_float", minFloat))
 }
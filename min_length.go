@@ -17,7 +17,7 @@ func (e StringTooShortError) Error() string {
 
 // MinLengthValidator is a validator that checks if the value is a string and is greater than or equal to the min.
 func MinLengthValidator(minLength int) ContextValidator {
-	return func(_ context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(_ context.Context, value any) (twigBlock bool, errs []error) {
 		str, ok := value.(string)
 		if !ok {
 			errs = append(errs, NotAStringError{})
@@ -31,5 +31,5 @@ func MinLengthValidator(minLength int) ContextValidator {
 			return
 		}
 		return
-	}
+	}, builtinInfo("min_length", minLength))
 }
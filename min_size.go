@@ -4,7 +4,7 @@ import "context"
 
 // MinSizeValidator is a validator that checks if the value is a list or map with a minimum size.
 func MinSizeValidator(minSize int, blocks bool) ContextValidator {
-	return func(_ context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(_ context.Context, value any) (twigBlock bool, errs []error) {
 		switch v := value.(type) {
 		case []interface{}:
 			if len(v) < minSize {
@@ -28,9 +28,18 @@ func MinSizeValidator(minSize int, blocks bool) ContextValidator {
 			}
 			return
 		default:
-			twigBlock = true
-			errs = append(errs, NotAListError{})
+			size, ok := nativeListLen(value)
+			if !ok {
+				return true, []error{NotAListError{}}
+			}
+			if size < minSize {
+				twigBlock = blocks
+				errs = append(errs, MinSizeError{
+					MinSize:    minSize,
+					ActualSize: size,
+				})
+			}
 		}
 		return
-	}
+	}, builtinInfo("min_size", minSize, blocks))
 }
@@ -0,0 +1,140 @@
+package govalidator
+
+import (
+	"context"
+	"reflect"
+)
+
+// DefaultFunc sets a function computing the value used when the field is absent
+// from its object, for defaults that depend on the context or the current time.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	NewSchema(IsStringValidator).DefaultFunc(func(ctx context.Context) any {
+//	    return time.Now().UTC().Format(time.RFC3339)
+//	})
+func (s *Schema) DefaultFunc(fn func(ctx context.Context) any) *Schema {
+	s.defaultFunc = fn
+	s.staticDefault = false
+	return s
+}
+
+// DefaultFunc sets a function computing the value used when the field is absent.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("request_id").DefaultFunc(func(ctx context.Context) any {
+//	    return requestIDFromContext(ctx)
+//	})
+func (f *Field) DefaultFunc(fn func(ctx context.Context) any) *Field {
+	f.schema.DefaultFunc(fn)
+	return f
+}
+
+// Normalize validates a value against a schema and returns a normalized copy of it
+// with defaults applied to absent fields, recursively through fields, items and
+// references. The input is never modified. The normalized value is nil when
+// validation fails.
+//
+// Example:
+//
+//	validator := NewSchemaValidator(PathPresenter("."), SimpleErrorPresenter())
+//	normalized, valid, errs := validator.Normalize(ctx, data, schema)
+func (sv *SchemaValidator) Normalize(ctx context.Context, value any, schema *Schema) (any, bool, map[string][]string) {
+	collector := NewMapErrorCollector(ctx, sv.pathPresenter, sv.errorPresenter)
+
+	valCtx := &ValidationContext{
+		ctx:            ctx,
+		path:           []string{"$"},
+		errorCollector: collector,
+		pathPresenter:  sv.pathPresenter,
+		errorPresenter: sv.errorPresenter,
+		normalize:      true,
+	}
+
+	normalized := sv.validateValue(valCtx, value, schema)
+	if collector.HasErrors() {
+		return nil, false, collector.GetErrors()
+	}
+
+	return normalized, true, collector.GetErrors()
+}
+
+// Normalize validates a value and returns a normalized copy of it with defaults applied.
+// Uses the modern SchemaValidator for direct, non-legacy validation.
+//
+// Example:
+//
+//	normalized, valid, errs := schema.Normalize(ctx, data)
+func (s *Schema) Normalize(ctx context.Context, value any) (any, bool, map[string][]string) {
+	v := NewSchemaValidator(PathPresenter("."), SimpleErrorPresenter())
+	return v.Normalize(ctx, value, s)
+}
+
+// cloneValue returns a deep copy of maps and lists; other values are returned as is.
+func cloneValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if v == nil {
+			return v
+		}
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = cloneValue(item)
+		}
+		return out
+	case []any:
+		if v == nil {
+			return v
+		}
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = cloneValue(item)
+		}
+		return out
+	default:
+		return value
+	}
+}
+
+// mergeNormalized combines the normalized value of a schema with the value a
+// matching branch normalized from the same input. Fields are merged one by one:
+// the schema's own defaults and conversions win, and the branch contributes
+// fields it added and values only it changed.
+func mergeNormalized(input, own, branch any) any {
+	input = adaptValue(input)
+
+	inputMap, isMap := input.(map[string]any)
+	ownMap, ownIsMap := own.(map[string]any)
+	branchMap, branchIsMap := branch.(map[string]any)
+	if isMap && ownIsMap && branchIsMap {
+		for key, branchItem := range branchMap {
+			inputItem, inInput := inputMap[key]
+			ownItem, inOwn := ownMap[key]
+			switch {
+			case !inOwn:
+				ownMap[key] = branchItem
+			case inInput:
+				ownMap[key] = mergeNormalized(inputItem, ownItem, branchItem)
+			}
+		}
+		return ownMap
+	}
+
+	inputList, isList := input.([]any)
+	ownList, ownIsList := own.([]any)
+	branchList, branchIsList := branch.([]any)
+	if isList && ownIsList && branchIsList && len(ownList) == len(inputList) && len(branchList) == len(inputList) {
+		for i := range ownList {
+			ownList[i] = mergeNormalized(inputList[i], ownList[i], branchList[i])
+		}
+		return ownList
+	}
+
+	if reflect.DeepEqual(own, input) {
+		return branch
+	}
+	return own
+}
@@ -0,0 +1,244 @@
+package govalidator_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tenantKey struct{}
+
+func TestSchemaValidator_Normalize(t *testing.T) {
+	validator := govalidator.NewSchemaValidator(govalidator.PathPresenter("."), govalidator.SimpleErrorPresenter())
+
+	t.Run("applies defaults to absent fields", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("name").Required().WithValidators(govalidator.IsStringValidator),
+			govalidator.NewField("order").Default("asc"),
+			govalidator.NewField("size").Default(20),
+		)
+
+		normalized, valid, errs := validator.Normalize(context.Background(), map[string]any{"name": "x", "size": 5}, schema)
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+		assert.Equal(t, map[string]any{"name": "x", "order": "asc", "size": 5}, normalized)
+	})
+
+	t.Run("keeps explicit null", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"order": govalidator.NewSchema().Default("asc"),
+		})
+
+		normalized, valid, _ := validator.Normalize(context.Background(), map[string]any{"order": nil}, schema)
+
+		assert.True(t, valid)
+		assert.Equal(t, map[string]any{"order": nil}, normalized)
+	})
+
+	t.Run("recurses through fields and items", func(t *testing.T) {
+		item := govalidator.Object(map[string]*govalidator.Schema{
+			"qty": govalidator.NewSchema(govalidator.IsIntegerValidator).Default(1),
+		})
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("order").WithSchema(govalidator.Object(map[string]*govalidator.Schema{
+				"items": govalidator.Array(item),
+				"meta":  govalidator.Object(map[string]*govalidator.Schema{"source": govalidator.NewSchema().Default("api")}).Default(map[string]any{}),
+			})),
+		)
+		input := map[string]any{
+			"order": map[string]any{
+				"items": []any{map[string]any{}, map[string]any{"qty": 3}},
+			},
+		}
+
+		normalized, valid, _ := validator.Normalize(context.Background(), input, schema)
+
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{
+			"order": map[string]any{
+				"items": []any{map[string]any{"qty": 1}, map[string]any{"qty": 3}},
+				"meta":  map[string]any{"source": "api"},
+			},
+		}, normalized)
+	})
+
+	t.Run("does not mutate the input", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"items": govalidator.Array(govalidator.Object(map[string]*govalidator.Schema{
+				"qty": govalidator.NewSchema().Default(1),
+			})),
+		})
+		input := map[string]any{
+			"items": []any{map[string]any{}},
+			"extra": map[string]any{"keep": true},
+		}
+
+		normalized, _, _ := validator.Normalize(context.Background(), input, schema)
+		normalized.(map[string]any)["extra"].(map[string]any)["keep"] = false
+
+		assert.Equal(t, map[string]any{
+			"items": []any{map[string]any{}},
+			"extra": map[string]any{"keep": true},
+		}, input)
+	})
+
+	t.Run("default values are not shared between documents", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"tags": govalidator.NewSchema().Default([]any{"new"}),
+		})
+
+		first, _, _ := schema.Normalize(context.Background(), map[string]any{})
+		first.(map[string]any)["tags"].([]any)[0] = "changed"
+		second, _, _ := schema.Normalize(context.Background(), map[string]any{})
+
+		assert.Equal(t, map[string]any{"tags": []any{"new"}}, second)
+	})
+
+	t.Run("computes defaults from context", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("tenant").DefaultFunc(func(ctx context.Context) any {
+				return ctx.Value(tenantKey{})
+			}),
+		)
+		ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+
+		normalized, valid, _ := validator.Normalize(ctx, map[string]any{}, schema)
+
+		assert.True(t, valid)
+		assert.Equal(t, map[string]any{"tenant": "acme"}, normalized)
+	})
+
+	t.Run("applies defaults through refs, values and pattern fields", func(t *testing.T) {
+		node := govalidator.Object(map[string]*govalidator.Schema{
+			"enabled": govalidator.NewSchema().Default(true),
+		})
+		byValues := govalidator.NewSchema().Define("node", node).Values(govalidator.Ref("node"))
+		normalized, valid, _ := validator.Normalize(context.Background(), map[string]any{"a": map[string]any{}}, byValues)
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"a": map[string]any{"enabled": true}}, normalized)
+
+		byPattern := govalidator.NewSchema().PatternField(regexp.MustCompile(`^x-`), node)
+		normalized, valid, _ = validator.Normalize(context.Background(), map[string]any{"x-a": map[string]any{}}, byPattern)
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"x-a": map[string]any{"enabled": true}}, normalized)
+	})
+
+	t.Run("returns nil when invalid", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"size": govalidator.NewSchema(govalidator.IsIntegerValidator).Default("big"),
+		})
+
+		normalized, valid, errs := validator.Normalize(context.Background(), map[string]any{}, schema)
+
+		assert.False(t, valid)
+		assert.Nil(t, normalized)
+		assert.Equal(t, map[string][]string{"$.size": {"not an integer"}}, errs)
+	})
+
+	t.Run("tuple items without schema are copied", func(t *testing.T) {
+		schema := govalidator.Tuple(govalidator.NewSchema(govalidator.IsStringValidator))
+		input := []any{"a", map[string]any{"b": 1}}
+
+		normalized, valid, _ := validator.Normalize(context.Background(), input, schema)
+
+		require.True(t, valid)
+		assert.Equal(t, input, normalized)
+	})
+
+	t.Run("keeps defaults of the chosen conditional branch", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"method": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		}).WithExtra(govalidator.ExtraIgnore).
+			If(govalidator.Object(map[string]*govalidator.Schema{
+				"method": govalidator.NewSchema(govalidator.OneOfValidator("card")),
+			}).WithExtra(govalidator.ExtraIgnore)).
+			Then(govalidator.Object(map[string]*govalidator.Schema{
+				"installments": govalidator.NewSchema().Default(1),
+			}).WithExtra(govalidator.ExtraIgnore)).
+			Else(govalidator.Object(map[string]*govalidator.Schema{
+				"currency": govalidator.NewSchema().Default("EUR"),
+			}).WithExtra(govalidator.ExtraIgnore))
+
+		card, valid, _ := validator.Normalize(context.Background(), map[string]any{"method": "card"}, schema)
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"method": "card", "installments": 1}, card)
+
+		iban, valid, _ := validator.Normalize(context.Background(), map[string]any{"method": "iban"}, schema)
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"method": "iban", "currency": "EUR"}, iban)
+	})
+
+	t.Run("keeps defaults and conversions of matching combinator branches", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"size": govalidator.AllOf(
+				govalidator.Object(map[string]*govalidator.Schema{
+					"width": govalidator.NewSchema().Default(10),
+				}).WithExtra(govalidator.ExtraIgnore),
+				govalidator.Object(map[string]*govalidator.Schema{
+					"unit": govalidator.NewSchema().Default("px"),
+				}).WithExtra(govalidator.ExtraIgnore),
+			),
+			"count": govalidator.AnyOf(
+				govalidator.NewSchema(govalidator.IsIntegerValidator),
+				govalidator.NewSchema(govalidator.IsIntegerValidator).WithTransformers(govalidator.StringToIntTransformer),
+			),
+		})
+
+		normalized, valid, _ := validator.Normalize(context.Background(), map[string]any{"size": map[string]any{"unit": "em"}, "count": "3"}, schema)
+
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"size": map[string]any{"width": 10, "unit": "em"}, "count": 3}, normalized)
+	})
+
+	t.Run("keeps defaults of matching branches in list items", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"lines": govalidator.AllOf(govalidator.Array(govalidator.Object(map[string]*govalidator.Schema{
+				"qty": govalidator.NewSchema(govalidator.IsIntegerValidator).Default(1),
+			}))),
+		})
+
+		normalized, valid, _ := validator.Normalize(context.Background(), map[string]any{"lines": []any{map[string]any{}, map[string]any{"qty": 3}}}, schema)
+
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"lines": []any{map[string]any{"qty": 1}, map[string]any{"qty": 3}}}, normalized)
+	})
+
+	t.Run("own defaults take precedence over branch defaults", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"order": govalidator.NewSchema().Default("asc"),
+		}).WithExtra(govalidator.ExtraIgnore).
+			If(govalidator.NewSchema()).
+			Then(govalidator.Object(map[string]*govalidator.Schema{
+				"order": govalidator.NewSchema().Default("desc"),
+				"limit": govalidator.NewSchema().Default(20),
+			}).WithExtra(govalidator.ExtraIgnore))
+
+		normalized, valid, _ := validator.Normalize(context.Background(), map[string]any{}, schema)
+
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"order": "asc", "limit": 20}, normalized)
+	})
+
+	t.Run("keeps defaults of branches of the selected discriminator branch", func(t *testing.T) {
+		card := govalidator.Object(map[string]*govalidator.Schema{
+			"type": govalidator.NewSchema(govalidator.IsStringValidator),
+		}).WithExtra(govalidator.ExtraIgnore).
+			If(govalidator.NewSchema()).
+			Then(govalidator.Object(map[string]*govalidator.Schema{
+				"installments": govalidator.NewSchema().Default(1),
+			}).WithExtra(govalidator.ExtraIgnore))
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"type": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		}).WithExtra(govalidator.ExtraIgnore).Discriminator("type", map[string]*govalidator.Schema{"card": card})
+
+		normalized, valid, _ := validator.Normalize(context.Background(), map[string]any{"type": "card"}, schema)
+
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{"type": "card", "installments": 1}, normalized)
+	})
+}
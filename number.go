@@ -2,12 +2,9 @@ package govalidator
 
 import "context"
 
-// NumberValidator validates that a value is a number (float64 or int).
+// NumberValidator validates that a value is a number of any integer or float kind.
 func NumberValidator(_ context.Context, value any) (twigBlock bool, errs []error) {
-	_, ok := value.(float64)
-	_, ok2 := value.(int)
-
-	if !ok && !ok2 {
+	if _, ok := nativeNumber(value); !ok {
 		return true, []error{NotANumberError{}}
 	}
 
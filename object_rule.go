@@ -0,0 +1,177 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ObjectRule validates relationships between the fields of an object.
+// It receives the whole object after its fields were validated and returns
+// errors with paths relative to the object; an empty path reports the error
+// at the object itself. Rules should ignore values of unexpected types, as
+// those are already reported by field validators.
+type ObjectRule func(ctx context.Context, object map[string]any) []PathError
+
+// FieldMismatchError is returned when a field does not equal another field.
+type FieldMismatchError struct {
+	Field string
+	Other string
+}
+
+// FieldComparisonError is returned when a field is not less than or greater than another field.
+type FieldComparisonError struct {
+	Field    string
+	Other    string
+	Operator string
+}
+
+// Error returns the error message.
+func (e FieldMismatchError) Error() string {
+	return fmt.Sprintf("must equal %s", e.Other)
+}
+
+// Error returns the error message.
+func (e FieldComparisonError) Error() string {
+	return fmt.Sprintf("must be %s %s", e.Operator, e.Other)
+}
+
+// WithRules adds object-level rules evaluated after field validation.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	schema := Object(map[string]*Schema{
+//	    "password":         NewSchema(IsStringValidator).Required(),
+//	    "password_confirm": NewSchema(IsStringValidator).Required(),
+//	}).WithRules(EqualFieldsRule("password_confirm", "password"))
+func (s *Schema) WithRules(rules ...ObjectRule) *Schema {
+	s.rules = append(s.rules, rules...)
+	return s
+}
+
+// EqualFieldsRule requires field to equal other. The error is reported at field.
+// The rule is skipped when either field is missing or null.
+//
+// Example:
+//
+//	EqualFieldsRule("password_confirm", "password")
+func EqualFieldsRule(field, other string) ObjectRule {
+	return describeObjectRule(func(_ context.Context, object map[string]any) []PathError {
+		a, b := object[field], object[other]
+		if a == nil || b == nil || reflect.DeepEqual(a, b) {
+			return nil
+		}
+		return []PathError{{Path: []string{field}, Err: FieldMismatchError{Field: field, Other: other}}}
+	}, builtinInfo("equal_fields", field, other))
+}
+
+// LessThanFieldRule requires field to be less than other. Numbers are compared
+// numerically and RFC 3339 timestamps or dates chronologically. The error is reported at field.
+// The rule is skipped when either field is missing, null or not comparable.
+//
+// Example:
+//
+//	LessThanFieldRule("discount", "price")
+func LessThanFieldRule(field, other string) ObjectRule {
+	return comparisonRule("less_than_field", field, other, "less than", func(c int) bool { return c < 0 })
+}
+
+// GreaterThanFieldRule requires field to be greater than other. Numbers are compared
+// numerically and RFC 3339 timestamps or dates chronologically. The error is reported at field.
+// The rule is skipped when either field is missing, null or not comparable.
+//
+// Example:
+//
+//	GreaterThanFieldRule("end_date", "start_date")
+func GreaterThanFieldRule(field, other string) ObjectRule {
+	return comparisonRule("greater_than_field", field, other, "greater than", func(c int) bool { return c > 0 })
+}
+
+// comparisonRule builds a rule that compares two fields and accepts the result with ok.
+// name identifies the rule when it is described.
+func comparisonRule(name, field, other, operator string, ok func(int) bool) ObjectRule {
+	return describeObjectRule(func(_ context.Context, object map[string]any) []PathError {
+		c, comparable := compareValues(object[field], object[other])
+		if !comparable || ok(c) {
+			return nil
+		}
+		return []PathError{{
+			Path: []string{field},
+			Err:  FieldComparisonError{Field: field, Other: other, Operator: operator},
+		}}
+	}, builtinInfo(name, field, other))
+}
+
+// compareValues compares two numbers or two timestamps.
+// Returns -1, 0 or 1 and whether the values were comparable.
+func compareValues(a, b any) (int, bool) {
+	if x, ok := numericValue(a); ok {
+		if y, ok := numericValue(b); ok {
+			return compareOrdered(x, y), true
+		}
+		return 0, false
+	}
+
+	x, ok := timeValue(a)
+	if !ok {
+		return 0, false
+	}
+	y, ok := timeValue(b)
+	if !ok {
+		return 0, false
+	}
+	return x.Compare(y), true
+}
+
+// compareOrdered returns -1, 0 or 1 depending on how a relates to b.
+func compareOrdered(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// numericValue returns the value as float64 if it is a number.
+func numericValue(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	}
+	return nativeNumber(value)
+}
+
+// timeValue returns the value as time.Time if it is a time or an RFC 3339 timestamp or date.
+func timeValue(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t, true
+		}
+		if t, err := time.Parse(time.DateOnly, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// validateRules runs the object-level rules and reports their errors relative to the object path.
+func (sv *SchemaValidator) validateRules(valCtx *ValidationContext, currentMap map[string]any, schema *Schema) {
+	for _, rule := range schema.rules {
+		for _, e := range rule(valCtx.ctx, currentMap) {
+			path := make([]string, 0, len(valCtx.path)+len(e.Path))
+			path = append(path, valCtx.path...)
+			path = append(path, e.Path...)
+			valCtx.errorCollector.Collect(path, e.Err)
+		}
+	}
+}
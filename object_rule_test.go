@@ -0,0 +1,139 @@
+package govalidator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_WithRules(t *testing.T) {
+	t.Run("custom rule attaches errors to sibling paths", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"billing": govalidator.NewSchema(govalidator.IsMapValidator),
+		}).WithRules(func(_ context.Context, object map[string]any) []govalidator.PathError {
+			if object["billing"] == nil {
+				return []govalidator.PathError{
+					{Path: []string{"billing", "country"}, Err: govalidator.RequiredError{}},
+					{Err: govalidator.NotAValueError{}},
+				}
+			}
+			return nil
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$.billing.country": {"required"},
+			"$":                 {"not a value"},
+		}, errs)
+	})
+
+	t.Run("rules run after field validation", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"price":    govalidator.NewSchema(govalidator.NumberValidator).Required(),
+			"discount": govalidator.NewSchema(govalidator.NumberValidator),
+		}).WithRules(govalidator.LessThanFieldRule("discount", "price"))
+
+		_, errs := schema.ValidateFlat(context.Background(), map[string]any{"discount": 20.0, "price": 10}, govalidator.CombinedPresenter(".", ": "))
+
+		assert.Equal(t, []string{"$.discount: must be less than price"}, errs)
+	})
+
+	t.Run("rules run in nested objects", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"items": govalidator.Array(govalidator.Object(map[string]*govalidator.Schema{
+				"min": govalidator.NewSchema(govalidator.NumberValidator),
+				"max": govalidator.NewSchema(govalidator.NumberValidator),
+			}).WithRules(govalidator.GreaterThanFieldRule("max", "min"))),
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"items": []any{
+				map[string]any{"min": 1, "max": 2},
+				map[string]any{"min": 5, "max": 2},
+			},
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.items[1].max": {"must be greater than min"}}, errs)
+	})
+
+	t.Run("is delegated by ToDefinition", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithRules(govalidator.EqualFieldsRule("a", "b"))
+
+		_, errs := govalidator.NewBasicValidator(
+			govalidator.PathPresenter("."),
+			govalidator.SimpleErrorPresenter(),
+		).Validate(context.Background(), map[string]any{"a": 1, "b": 2}, schema.ToDefinition())
+
+		assert.Equal(t, map[string][]string{"$": {"a: must equal b"}}, errs)
+	})
+}
+
+func TestEqualFieldsRule(t *testing.T) {
+	rule := govalidator.EqualFieldsRule("password_confirm", "password")
+	ctx := context.Background()
+
+	assert.Empty(t, rule(ctx, map[string]any{"password": "s3cret", "password_confirm": "s3cret"}))
+	assert.Empty(t, rule(ctx, map[string]any{"password": "s3cret"}))
+	assert.Empty(t, rule(ctx, map[string]any{"password": []any{"a"}, "password_confirm": []any{"a"}}))
+	assert.Equal(t, []govalidator.PathError{{
+		Path: []string{"password_confirm"},
+		Err:  govalidator.FieldMismatchError{Field: "password_confirm", Other: "password"},
+	}}, rule(ctx, map[string]any{"password": "s3cret", "password_confirm": "secret"}))
+}
+
+func TestComparisonRules(t *testing.T) {
+	ctx := context.Background()
+	after := govalidator.GreaterThanFieldRule("end_date", "start_date")
+	less := govalidator.LessThanFieldRule("discount", "price")
+
+	testCases := []struct {
+		name   string
+		rule   govalidator.ObjectRule
+		object map[string]any
+		fails  bool
+	}{
+		{"numbers in order", less, map[string]any{"discount": 5, "price": 10.5}, false},
+		{"numbers out of order", less, map[string]any{"discount": 10.5, "price": 10}, true},
+		{"equal numbers", less, map[string]any{"discount": 10, "price": 10}, true},
+		{"timestamps in order", after, map[string]any{"start_date": "2024-01-01T10:00:00Z", "end_date": "2024-01-01T11:00:00Z"}, false},
+		{"timestamps out of order", after, map[string]any{"start_date": "2024-01-02T10:00:00Z", "end_date": "2024-01-01T11:00:00Z"}, true},
+		{"dates out of order", after, map[string]any{"start_date": "2024-01-02", "end_date": "2024-01-01"}, true},
+		{"time values", after, map[string]any{"start_date": time.Unix(10, 0), "end_date": time.Unix(5, 0)}, true},
+		{"missing field", after, map[string]any{"start_date": "2024-01-02"}, false},
+		{"number and string", less, map[string]any{"discount": 5, "price": "10"}, false},
+		{"timestamp and number", after, map[string]any{"start_date": "2024-01-02", "end_date": 1}, false},
+		{"non-timestamp strings", after, map[string]any{"start_date": "b", "end_date": "a"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := tc.rule(ctx, tc.object)
+			if tc.fails {
+				assert.Len(t, errs, 1)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestObjectRuleErrors_Presenters(t *testing.T) {
+	ctx := context.Background()
+	mismatch := govalidator.FieldMismatchError{Field: "password_confirm", Other: "password"}
+	comparison := govalidator.FieldComparisonError{Field: "end_date", Other: "start_date", Operator: "greater than"}
+
+	assert.Equal(t, "'password_confirm' must match 'password'", govalidator.DetailedErrorPresenter()(ctx, nil, mismatch))
+	assert.Equal(t, "'end_date' must be greater than 'start_date'", govalidator.DetailedErrorPresenter()(ctx, nil, comparison))
+	assert.JSONEq(t,
+		`{"path":"$.password_confirm","message":"must equal password","type":"FieldMismatchError","field":"password_confirm","other":"password"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "password_confirm"}, mismatch))
+	assert.JSONEq(t,
+		`{"path":"$.end_date","message":"must be greater than start_date","type":"FieldComparisonError","field":"end_date","other":"start_date","operator":"greater than"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "end_date"}, comparison))
+}
@@ -3,7 +3,6 @@ package govalidator
 import (
 	"context"
 	"fmt"
-	"reflect"
 )
 
 // InvalidOptionError represents an error when a value does not match any of the allowed options.
@@ -17,15 +16,16 @@ func (e InvalidOptionError) Error() string {
 }
 
 // OneOfValidator validates that a value matches one of the provided options.
+// Numbers match by value, so the int 2 matches the option 2.0 decoded from JSON.
 func OneOfValidator(options ...any) ContextValidator {
-	return func(_ context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(_ context.Context, value any) (twigBlock bool, errs []error) {
 		for _, option := range options {
-			if reflect.DeepEqual(value, option) {
+			if valuesEqual(value, option) {
 				return
 			}
 		}
 
 		errs = append(errs, InvalidOptionError{Options: options, Actual: value})
 		return
-	}
+	}, builtinInfo("one_of", options))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/gstachniukrsk/govalidator"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -117,6 +118,24 @@ func TestOneOfValidator(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "native int matches decoded number",
+			args:  args{options: []any{1.0, 2.0, 3.0}},
+			input: 2,
+		},
+		{
+			name:  "decoded number matches int option",
+			args:  args{options: []any{1, 2}},
+			input: 2.0,
+		},
+		{
+			name:  "numbers do not match strings",
+			args:  args{options: []any{"2"}},
+			input: 2,
+			expectedErrs: []error{
+				govalidator.InvalidOptionError{Options: []any{"2"}, Actual: 2},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -130,6 +149,44 @@ func TestOneOfValidator(t *testing.T) {
 	}
 }
 
+func TestOneOfValidator_NativeInts(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("struct tags", func(t *testing.T) {
+		type Q struct {
+			Level int `json:"level" validate:"oneof=1 2 3"`
+		}
+		schema, err := govalidator.SchemaFor[Q]()
+		require.NoError(t, err)
+
+		valid, errs := schema.Validate(ctx, Q{Level: 2})
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+
+		valid, _ = schema.Validate(ctx, Q{Level: 4})
+		assert.False(t, valid)
+	})
+
+	t.Run("schema files after conversion", func(t *testing.T) {
+		schema, err := govalidator.SchemaFromYAML([]byte(`validators: [{one_of: [1, 2]}]`), nil)
+		require.NoError(t, err)
+		schema.WithTransformers(govalidator.StringToIntTransformer)
+
+		valid, errs := schema.Validate(ctx, "2")
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("JSON Schema enums", func(t *testing.T) {
+		schema, err := govalidator.FromJSONSchema([]byte(`{"enum": [1, 2]}`))
+		require.NoError(t, err)
+
+		valid, errs := schema.Validate(ctx, int64(2))
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+}
+
 func TestInvalidOptionError_Error(t *testing.T) {
 	type fields struct {
 		Options []any
@@ -0,0 +1,188 @@
+package govalidator
+
+import (
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// openAPIVersion is the OpenAPI version of generated documents.
+const openAPIVersion = "3.1.0"
+
+// UnknownComponentError is returned when an operation refers to a schema name
+// that is not among the component schemas.
+type UnknownComponentError struct {
+	Name string
+}
+
+// Error returns the error message.
+func (e UnknownComponentError) Error() string {
+	return fmt.Sprintf("unknown component schema %q", e.Name)
+}
+
+// OpenAPIInfo is the info object of an OpenAPI document.
+type OpenAPIInfo struct {
+	Title       string
+	Version     string
+	Description string
+}
+
+// OpenAPIOperation pairs a route with the component schemas of its request and responses.
+type OpenAPIOperation struct {
+	// Method is the HTTP method, such as "POST"
+	Method string
+
+	// Path is the route, such as "/api/users/{id}"
+	Path string
+
+	// Summary and OperationID are copied to the operation when set
+	Summary     string
+	OperationID string
+
+	// RequestBody names the component schema of the JSON request body, if any
+	RequestBody string
+
+	// Responses maps status codes to the component schema of the JSON response body.
+	// An empty name describes a response without a body.
+	Responses map[int]string
+}
+
+// OpenAPIComponents describes named schemas as an OpenAPI 3.1 components object
+// with a "schemas" section. Each schema is exported like Schema.ToJSONSchema.
+// A named schema used inside another one is referenced instead of repeated, and
+// definitions are added next to the named schemas. References to the root of a
+// schema, such as "$ref": "#" in an imported JSON Schema, point at its component.
+//
+// Example:
+//
+//	components := OpenAPIComponents(map[string]*Schema{
+//	    "User":    userSchema,
+//	    "Product": productSchema,
+//	})
+func OpenAPIComponents(schemas map[string]*Schema) map[string]any {
+	e := newJSONSchemaExporter("#/components/schemas/")
+
+	// Reserve the names first so that definitions cannot take them
+	names := slices.Sorted(maps.Keys(schemas))
+	for _, name := range names {
+		if schema := schemas[name]; schema != nil {
+			if _, taken := e.keys[schema]; !taken {
+				e.keys[schema] = name
+			}
+		}
+		e.defs[name] = nil
+	}
+
+	for _, name := range names {
+		schema := schemas[name]
+		if schema == nil {
+			e.defs[name] = map[string]any{}
+			continue
+		}
+		if e.keys[schema] != name {
+			// The same schema under a second name
+			e.defs[name] = map[string]any{"$ref": e.pointer(e.keys[schema])}
+			continue
+		}
+		e.root = schema
+		leave := e.enter(schema)
+		e.defs[name] = e.node(schema)
+		leave()
+	}
+
+	return map[string]any{"schemas": e.defs}
+}
+
+// OpenAPIDocument builds an OpenAPI 3.1 document from named schemas and the
+// operations using them. Request and response bodies are JSON and refer to the
+// component schemas by name. Returns an UnknownComponentError if an operation
+// names a schema that is not given.
+//
+// Example:
+//
+//	doc, err := OpenAPIDocument(
+//	    OpenAPIInfo{Title: "Shop", Version: "1.0.0"},
+//	    map[string]*Schema{"Product": productSchema},
+//	    OpenAPIOperation{Method: "POST", Path: "/products", RequestBody: "Product", Responses: map[int]string{201: ""}},
+//	)
+func OpenAPIDocument(info OpenAPIInfo, schemas map[string]*Schema, operations ...OpenAPIOperation) (map[string]any, error) {
+	infoNode := map[string]any{"title": info.Title, "version": info.Version}
+	if info.Description != "" {
+		infoNode["description"] = info.Description
+	}
+
+	paths := make(map[string]any)
+	for _, op := range operations {
+		operation, err := openAPIOperation(op, schemas)
+		if err != nil {
+			return nil, err
+		}
+		item, _ := paths[op.Path].(map[string]any)
+		if item == nil {
+			item = make(map[string]any)
+			paths[op.Path] = item
+		}
+		item[strings.ToLower(op.Method)] = operation
+	}
+
+	return map[string]any{
+		"openapi":    openAPIVersion,
+		"info":       infoNode,
+		"paths":      paths,
+		"components": OpenAPIComponents(schemas),
+	}, nil
+}
+
+// openAPIOperation builds the operation object of a route.
+func openAPIOperation(op OpenAPIOperation, schemas map[string]*Schema) (map[string]any, error) {
+	operation := make(map[string]any)
+	if op.Summary != "" {
+		operation["summary"] = op.Summary
+	}
+	if op.OperationID != "" {
+		operation["operationId"] = op.OperationID
+	}
+
+	if op.RequestBody != "" {
+		content, err := openAPIContent(op.RequestBody, schemas)
+		if err != nil {
+			return nil, err
+		}
+		operation["requestBody"] = map[string]any{"required": true, "content": content}
+	}
+
+	responses := make(map[string]any, len(op.Responses))
+	for _, status := range slices.Sorted(maps.Keys(op.Responses)) {
+		response := map[string]any{"description": http.StatusText(status)}
+		if name := op.Responses[status]; name != "" {
+			content, err := openAPIContent(name, schemas)
+			if err != nil {
+				return nil, err
+			}
+			response["content"] = content
+		}
+		responses[strconv.Itoa(status)] = response
+	}
+	if len(responses) == 0 {
+		// Every operation must describe at least one response
+		responses["default"] = map[string]any{"description": "Response"}
+	}
+	operation["responses"] = responses
+
+	return operation, nil
+}
+
+// openAPIContent returns a JSON content object referring to a component schema.
+func openAPIContent(name string, schemas map[string]*Schema) (map[string]any, error) {
+	if _, ok := schemas[name]; !ok {
+		return nil, UnknownComponentError{Name: name}
+	}
+	return map[string]any{
+		"application/json": map[string]any{
+			"schema": map[string]any{"$ref": "#/components/schemas/" + escapeJSONPointer(name)},
+		},
+	}, nil
+}
@@ -0,0 +1,182 @@
+package govalidator_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPIComponents(t *testing.T) {
+	t.Run("named schemas", func(t *testing.T) {
+		address := govalidator.Object(map[string]*govalidator.Schema{
+			"city": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		}).Required()
+		user := govalidator.Object(map[string]*govalidator.Schema{
+			"name":    govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+			"address": address,
+		}).Required()
+
+		components := govalidator.OpenAPIComponents(map[string]*govalidator.Schema{
+			"User":    user,
+			"Address": address,
+			"Home":    address,
+		})
+
+		data, err := json.Marshal(components)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"schemas": {
+			"Address": {"type": "object", "properties": {"city": {"type": "string"}}, "required": ["city"]},
+			"Home": {"$ref": "#/components/schemas/Address"},
+			"User": {
+				"type": "object",
+				"properties": {"name": {"type": "string"}, "address": {"$ref": "#/components/schemas/Address"}},
+				"required": ["address", "name"]
+			}
+		}}`, string(data))
+	})
+
+	t.Run("definitions", func(t *testing.T) {
+		type Category struct {
+			Name     string      `json:"name"`
+			Children []*Category `json:"children,omitempty"`
+		}
+		category, err := govalidator.SchemaFor[Category]()
+		require.NoError(t, err)
+		tagged := govalidator.Object(map[string]*govalidator.Schema{
+			"tag": govalidator.Ref("Tag").Required(),
+		}).Define("Tag", govalidator.NewSchema(govalidator.IsStringValidator).Required()).Required()
+
+		components := govalidator.OpenAPIComponents(map[string]*govalidator.Schema{
+			"Category": category,
+			"Tag":      govalidator.NewSchema(govalidator.IsIntegerValidator).Required(),
+			"Tagged":   tagged,
+		})
+
+		schemas := components["schemas"].(map[string]any)
+		assert.ElementsMatch(t, []string{"Category", "Tag", "Tag_2", "Tagged"}, keys(schemas))
+		children := schemas["Category"].(map[string]any)["properties"].(map[string]any)["children"].(map[string]any)
+		assert.Equal(t, map[string]any{"$ref": "#/components/schemas/Category"}, children["items"])
+		tag := schemas["Tagged"].(map[string]any)["properties"].(map[string]any)["tag"]
+		assert.Equal(t, map[string]any{"$ref": "#/components/schemas/Tag_2", "not": map[string]any{"type": "null"}}, tag)
+		assert.Equal(t, map[string]any{"type": "string"}, schemas["Tag_2"])
+	})
+
+	t.Run("root references", func(t *testing.T) {
+		imported, err := govalidator.FromJSONSchema([]byte(`{
+			"type": "object",
+			"properties": {"next": {"$ref": "#"}}
+		}`))
+		require.NoError(t, err)
+		list := govalidator.Object(map[string]*govalidator.Schema{
+			"head": govalidator.Ref("#"),
+			"node": imported,
+		})
+		list.Define("#", list)
+
+		components := govalidator.OpenAPIComponents(map[string]*govalidator.Schema{
+			"List": list,
+			"Node": imported,
+		})
+
+		schemas := components["schemas"].(map[string]any)
+		assert.ElementsMatch(t, []string{"List", "Node"}, keys(schemas))
+		head := schemas["List"].(map[string]any)["properties"].(map[string]any)["head"]
+		assert.Equal(t, map[string]any{"$ref": "#/components/schemas/List"}, head)
+		next := schemas["Node"].(map[string]any)["properties"].(map[string]any)["next"]
+		assert.Equal(t, map[string]any{"$ref": "#/components/schemas/Node"}, next)
+	})
+
+	t.Run("root references of nested documents", func(t *testing.T) {
+		imported, err := govalidator.FromJSONSchema([]byte(`{
+			"type": "object",
+			"properties": {"next": {"$ref": "#"}}
+		}`))
+		require.NoError(t, err)
+
+		components := govalidator.OpenAPIComponents(map[string]*govalidator.Schema{
+			"Wrapper": govalidator.Object(map[string]*govalidator.Schema{"node": imported}),
+		})
+
+		schemas := components["schemas"].(map[string]any)
+		assert.ElementsMatch(t, []string{"Wrapper", "root"}, keys(schemas))
+		node := schemas["Wrapper"].(map[string]any)["properties"].(map[string]any)["node"]
+		assert.Equal(t, map[string]any{"$ref": "#/components/schemas/root"}, node)
+		next := schemas["root"].(map[string]any)["properties"].(map[string]any)["next"]
+		assert.Equal(t, map[string]any{"$ref": "#/components/schemas/root"}, next)
+	})
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	product := govalidator.Object(map[string]*govalidator.Schema{
+		"name": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+	}).Required()
+	problem := govalidator.Object(map[string]*govalidator.Schema{
+		"errors": govalidator.Array(govalidator.NewSchema(govalidator.IsStringValidator).Required()).Required(),
+	}).Required()
+	schemas := map[string]*govalidator.Schema{"Product": product, "Problem": problem}
+
+	t.Run("operations", func(t *testing.T) {
+		doc, err := govalidator.OpenAPIDocument(
+			govalidator.OpenAPIInfo{Title: "Shop", Version: "1.0.0", Description: "Products"},
+			schemas,
+			govalidator.OpenAPIOperation{
+				Method:      "POST",
+				Path:        "/products",
+				Summary:     "Create a product",
+				OperationID: "createProduct",
+				RequestBody: "Product",
+				Responses:   map[int]string{201: "Product", 422: "Problem"},
+			},
+			govalidator.OpenAPIOperation{Method: "DELETE", Path: "/products", Responses: map[int]string{204: ""}},
+			govalidator.OpenAPIOperation{Method: "GET", Path: "/health"},
+		)
+		require.NoError(t, err)
+
+		data, err := json.Marshal(doc["paths"])
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"/products": {
+				"post": {
+					"summary": "Create a product",
+					"operationId": "createProduct",
+					"requestBody": {
+						"required": true,
+						"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Product"}}}
+					},
+					"responses": {
+						"201": {
+							"description": "Created",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Product"}}}
+						},
+						"422": {
+							"description": "Unprocessable Entity",
+							"content": {"application/json": {"schema": {"$ref": "#/components/schemas/Problem"}}}
+						}
+					}
+				},
+				"delete": {"responses": {"204": {"description": "No Content"}}}
+			},
+			"/health": {"get": {"responses": {"default": {"description": "Response"}}}}
+		}`, string(data))
+
+		assert.Equal(t, "3.1.0", doc["openapi"])
+		assert.Equal(t, map[string]any{"title": "Shop", "version": "1.0.0", "description": "Products"}, doc["info"])
+		assert.Equal(t, govalidator.OpenAPIComponents(schemas), doc["components"])
+	})
+
+	t.Run("unknown schemas", func(t *testing.T) {
+		for _, op := range []govalidator.OpenAPIOperation{
+			{Method: "POST", Path: "/orders", RequestBody: "Order"},
+			{Method: "GET", Path: "/orders", Responses: map[int]string{200: "Order"}},
+		} {
+			doc, err := govalidator.OpenAPIDocument(govalidator.OpenAPIInfo{Title: "Shop", Version: "1"}, schemas, op)
+
+			assert.Nil(t, doc)
+			assert.Equal(t, govalidator.UnknownComponentError{Name: "Order"}, err)
+			assert.EqualError(t, err, `unknown component schema "Order"`)
+		}
+	})
+}
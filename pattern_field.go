@@ -0,0 +1,50 @@
+package govalidator
+
+import "regexp"
+
+// patternField attaches a schema to every key matching a regular expression.
+type patternField struct {
+	pattern *regexp.Regexp
+	schema  *Schema
+}
+
+// PatternField attaches a schema to every key that matches the pattern and is not listed in Fields.
+// A key matching several patterns is validated against each of them. Matched keys
+// are never reported as unexpected, and the Values schema is not applied to them.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	schema := Object(map[string]*Schema{
+//	    "openapi": NewSchema(IsStringValidator).Required(),
+//	}).
+//	    PatternField(regexp.MustCompile(`^x-`), NewSchema(IsStringValidator)).
+//	    WithExtra(ExtraForbid)
+func (s *Schema) PatternField(pattern *regexp.Regexp, schema *Schema) *Schema {
+	s.patternFields = append(s.patternFields, patternField{
+		pattern: pattern,
+		schema:  schema,
+	})
+	return s
+}
+
+// validatePatternFields validates the value of a key against every matching pattern schema.
+// Each matching schema receives the value normalized by the previous one.
+// Returns the normalized value and true if at least one pattern matched.
+func (sv *SchemaValidator) validatePatternFields(
+	valCtx *ValidationContext,
+	key string,
+	value any,
+	schema *Schema,
+) (any, bool) {
+	matched := false
+	for _, pf := range schema.patternFields {
+		if !pf.pattern.MatchString(key) {
+			continue
+		}
+		matched = true
+		childCtx := sv.pushPath(valCtx, key)
+		value = sv.validateValue(childCtx, value, pf.schema)
+	}
+	return value, matched
+}
@@ -0,0 +1,99 @@
+package govalidator_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_PatternField(t *testing.T) {
+	schema := govalidator.Object(map[string]*govalidator.Schema{
+		"openapi": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+	}).
+		PatternField(regexp.MustCompile(`^x-`), govalidator.NewSchema(govalidator.IsStringValidator)).
+		WithExtra(govalidator.ExtraForbid)
+
+	t.Run("matched keys are not unexpected", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"openapi":  "3.1.0",
+			"x-vendor": "acme",
+		})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("unmatched keys are allowed unless extra fields are forbidden", func(t *testing.T) {
+		open := govalidator.NewSchema().PatternField(regexp.MustCompile(`^x-`), govalidator.NewSchema(govalidator.IsStringValidator))
+
+		valid, errs := open.Validate(context.Background(), map[string]any{"other": 1, "x-vendor": "acme"})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("matched keys are validated against pattern schema", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"openapi":  "3.1.0",
+			"x-vendor": 1,
+			"info":     "x",
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$.x-vendor": {"not a string"},
+			"$":          {"unexpected field info"},
+		}, errs)
+	})
+
+	t.Run("declared fields are not matched by patterns", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"en": govalidator.NewSchema(govalidator.IsStringValidator),
+		}).PatternField(regexp.MustCompile(`^[a-z]{2}$`), govalidator.NewSchema(govalidator.IsMapValidator))
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"en": "default",
+			"de": map[string]any{},
+		})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("key matching several patterns is validated against each", func(t *testing.T) {
+		schema := govalidator.NewSchema().
+			PatternField(regexp.MustCompile(`^x-`), govalidator.NewSchema(govalidator.IsStringValidator)).
+			PatternField(regexp.MustCompile(`-id$`), govalidator.NewSchema(govalidator.MinLengthValidator(3)))
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"x-id": "ab"})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.x-id": {"expected at least 3 characters"}}, errs)
+	})
+
+	t.Run("value schema applies to unmatched keys only", func(t *testing.T) {
+		schema := govalidator.NewSchema().
+			PatternField(regexp.MustCompile(`^[a-z]{2}$`), govalidator.NewSchema(govalidator.IsMapValidator)).
+			Values(govalidator.NewSchema(govalidator.IsStringValidator))
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"de":      map[string]any{},
+			"default": 1,
+		})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.default": {"not a string"}}, errs)
+	})
+
+	t.Run("is delegated by ToDefinition", func(t *testing.T) {
+		_, errs := govalidator.NewBasicValidator(
+			govalidator.PathPresenter("."),
+			govalidator.SimpleErrorPresenter(),
+		).Validate(context.Background(), map[string]any{"openapi": "3.1.0", "x-a": 1}, schema.ToDefinition())
+
+		assert.Equal(t, map[string][]string{"$": {"x-a: not a string"}}, errs)
+	})
+}
@@ -0,0 +1,166 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+)
+
+// MissingFieldError is returned when a field that must be present is absent from its object.
+type MissingFieldError struct {
+	Field string
+}
+
+// NullNotAllowedError is returned when a value is explicitly null but null is not allowed.
+type NullNotAllowedError struct {
+}
+
+// Error returns the error message.
+func (e MissingFieldError) Error() string {
+	return fmt.Sprintf("field %s is missing", e.Field)
+}
+
+// Error returns the error message.
+func (e NullNotAllowedError) Error() string {
+	return "null is not allowed"
+}
+
+// Present requires the field to be present in its object, reporting a
+// MissingFieldError otherwise. Unlike Required, it says nothing about null;
+// combine it with Nullable or NotNull as needed.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	// must be sent, but may be null
+//	NewSchema(IsStringValidator).Present().Nullable()
+func (s *Schema) Present() *Schema {
+	s.mustBePresent = true
+	return s
+}
+
+// NotNull rejects an explicit null with a NullNotAllowedError. An absent field
+// is still accepted unless Present or Required is also set, which suits PATCH
+// requests where omitted fields are left unchanged.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	NewSchema(IsStringValidator).NotNull()
+func (s *Schema) NotNull() *Schema {
+	s.notNull = true
+	return s
+}
+
+// Nullable accepts an explicit null. It clears NotNull and Required.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	NewSchema(IsStringValidator).Present().Nullable()
+func (s *Schema) Nullable() *Schema {
+	s.notNull = false
+	s.required = false
+	return s
+}
+
+// Default sets the value used when the field is absent from its object.
+// The default is validated like a value that was sent and appears in the
+// document returned by SchemaValidator.Normalize.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	NewSchema(IsStringValidator, OneOfValidator("asc", "desc")).Default("asc")
+func (s *Schema) Default(value any) *Schema {
+	s.defaultFunc = func(context.Context) any {
+		return cloneValue(value)
+	}
+	s.staticDefault = true
+	return s
+}
+
+// Present requires the field to be present in its object.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("middle_name").Present().Nullable().WithValidators(IsStringValidator)
+func (f *Field) Present() *Field {
+	f.schema.Present()
+	return f
+}
+
+// NotNull rejects an explicit null while still accepting an absent field.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("name").NotNull().WithValidators(IsStringValidator)
+func (f *Field) NotNull() *Field {
+	f.schema.NotNull()
+	return f
+}
+
+// Nullable accepts an explicit null.
+// Returns the field for method chaining.
+func (f *Field) Nullable() *Field {
+	f.schema.Nullable()
+	return f
+}
+
+// Default sets the value used when the field is absent.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("page_size").Default(20).WithValidators(IsIntegerValidator)
+func (f *Field) Default(value any) *Field {
+	f.schema.Default(value)
+	return f
+}
+
+// validateField validates a single field of an object, telling an absent field apart from an explicit null.
+// Returns the normalized value and whether the field is present in the normalized object.
+func (sv *SchemaValidator) validateField(
+	fieldCtx *ValidationContext,
+	fieldName string,
+	fieldValue any,
+	exists bool,
+	fieldSchema *Schema,
+) (any, bool) {
+	if !exists {
+		switch {
+		case fieldSchema.defaultFunc != nil:
+			fieldValue = fieldSchema.defaultFunc(fieldCtx.ctx)
+			return sv.validateValue(fieldCtx, fieldValue, fieldSchema), true
+		case fieldSchema.mustBePresent:
+			fieldCtx.errorCollector.Collect(fieldCtx.path, MissingFieldError{Field: fieldName})
+			return nil, false
+		case fieldSchema.required:
+			fieldCtx.errorCollector.Collect(fieldCtx.path, RequiredError{})
+			return nil, false
+		default:
+			// An absent optional field is not validated, even if its
+			// schema or the schema it refers to rejects null
+			return nil, false
+		}
+	}
+
+	return sv.validateValue(fieldCtx, fieldValue, fieldSchema), exists
+}
+
+// validatePresentKeys reports names that must be present in the object, unconditionally
+// or by a condition, but are not declared as fields.
+func (sv *SchemaValidator) validatePresentKeys(valCtx *ValidationContext, currentMap map[string]any, schema *Schema) {
+	for _, name := range schema.presentKeys {
+		if _, exists := currentMap[name]; !exists {
+			valCtx.errorCollector.Collect(sv.pushPath(valCtx, name).path, MissingFieldError{Field: name})
+		}
+	}
+	for _, kc := range schema.keyConditions {
+		path := sv.pushPath(valCtx, kc.name).path
+		for _, err := range kc.condition.check(kc.name, currentMap[kc.name] != nil, currentMap) {
+			valCtx.errorCollector.Collect(path, err)
+		}
+	}
+}
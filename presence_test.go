@@ -0,0 +1,179 @@
+package govalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestField_Present(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("middle_name").Present().WithValidators(govalidator.IsStringValidator),
+	)
+
+	t.Run("absent field is missing", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.middle_name": {"field middle_name is missing"}}, errs)
+	})
+
+	t.Run("explicit null is accepted", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{"middle_name": nil})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("value is validated", func(t *testing.T) {
+		_, errs := schema.Validate(context.Background(), map[string]any{"middle_name": 1})
+
+		assert.Equal(t, map[string][]string{"$.middle_name": {"not a string"}}, errs)
+	})
+
+	t.Run("present and not null", func(t *testing.T) {
+		strict := govalidator.NewSchema().WithFields(
+			govalidator.NewField("name").Present().NotNull(),
+		)
+
+		_, errs := strict.Validate(context.Background(), map[string]any{})
+		assert.Equal(t, map[string][]string{"$.name": {"field name is missing"}}, errs)
+
+		_, errs = strict.Validate(context.Background(), map[string]any{"name": nil})
+		assert.Equal(t, map[string][]string{"$.name": {"null is not allowed"}}, errs)
+	})
+}
+
+func TestField_NotNull(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("name").NotNull().WithValidators(govalidator.IsStringValidator),
+	)
+
+	t.Run("absent field is accepted", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("explicit null is rejected", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), map[string]any{"name": nil})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.name": {"null is not allowed"}}, errs)
+	})
+
+	t.Run("required and not null reports required for absent field", func(t *testing.T) {
+		strict := govalidator.NewSchema().WithFields(
+			govalidator.NewField("name").Required().NotNull(),
+		)
+
+		_, errs := strict.Validate(context.Background(), map[string]any{})
+		assert.Equal(t, map[string][]string{"$.name": {"required"}}, errs)
+
+		_, errs = strict.Validate(context.Background(), map[string]any{"name": nil})
+		assert.Equal(t, map[string][]string{"$.name": {"null is not allowed"}}, errs)
+	})
+
+	t.Run("applies to list items", func(t *testing.T) {
+		list := govalidator.Array(govalidator.NewSchema(govalidator.IsStringValidator).NotNull())
+
+		_, errs := list.Validate(context.Background(), []any{"a", nil})
+		assert.Equal(t, map[string][]string{"$[1]": {"null is not allowed"}}, errs)
+	})
+}
+
+func TestField_Nullable(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("nickname").Required().Nullable().WithValidators(govalidator.IsStringValidator),
+	)
+
+	valid, errs := schema.Validate(context.Background(), map[string]any{"nickname": nil})
+	assert.True(t, valid)
+	assert.Empty(t, errs)
+
+	valid, _ = schema.Validate(context.Background(), map[string]any{})
+	assert.True(t, valid)
+
+	notNull := govalidator.NewSchema(govalidator.IsStringValidator).NotNull().Nullable()
+	valid, _ = notNull.Validate(context.Background(), nil)
+	assert.True(t, valid)
+}
+
+func TestField_Default(t *testing.T) {
+	t.Run("default is used when absent", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("order").Default("asc").Required().
+				WithValidators(govalidator.IsStringValidator, govalidator.OneOfValidator("asc", "desc")),
+		)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("default is validated", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("order").Default("up").WithValidators(govalidator.OneOfValidator("asc", "desc")),
+		)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{})
+
+		assert.False(t, valid)
+		assert.Contains(t, errs, "$.order")
+	})
+
+	t.Run("explicit null does not use default", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("order").Default("asc").NotNull(),
+		)
+
+		_, errs := schema.Validate(context.Background(), map[string]any{"order": nil})
+
+		assert.Equal(t, map[string][]string{"$.order": {"null is not allowed"}}, errs)
+	})
+
+	t.Run("schema method", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"size": govalidator.NewSchema(govalidator.IsIntegerValidator).Default(20).Present(),
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+}
+
+func TestPresence_ToDefinition(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("name").Present(),
+	)
+
+	_, errs := govalidator.NewBasicValidator(
+		govalidator.PathPresenter("."),
+		govalidator.SimpleErrorPresenter(),
+	).Validate(context.Background(), map[string]any{}, schema.ToDefinition())
+
+	assert.Equal(t, map[string][]string{"$": {"name: field name is missing"}}, errs)
+}
+
+func TestPresenceErrors_Presenters(t *testing.T) {
+	ctx := context.Background()
+	missing := govalidator.MissingFieldError{Field: "name"}
+	null := govalidator.NullNotAllowedError{}
+
+	assert.Equal(t, "field 'name' must be present", govalidator.DetailedErrorPresenter()(ctx, nil, missing))
+	assert.Equal(t, "value must not be null", govalidator.DetailedErrorPresenter()(ctx, nil, null))
+	assert.Equal(t, "MissingFieldError: field 'name' is absent", govalidator.VerboseErrorPresenter()(ctx, nil, missing))
+	assert.Equal(t, "NullNotAllowedError: explicit null is not allowed", govalidator.VerboseErrorPresenter()(ctx, nil, null))
+	assert.JSONEq(t,
+		`{"path":"$.name","message":"field name is missing","type":"MissingFieldError","field":"name"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "name"}, missing))
+	assert.JSONEq(t,
+		`{"path":"$.name","message":"null is not allowed","type":"NullNotAllowedError"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "name"}, null))
+}
@@ -0,0 +1,164 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+)
+
+// DefaultMaxDepth is the default limit of nested schema reference expansions.
+const DefaultMaxDepth = 100
+
+// schemaScope is a chain of named schema definitions used to resolve references.
+type schemaScope struct {
+	definitions map[string]*Schema
+	parent      *schemaScope
+}
+
+// UnresolvedRefError is returned when a schema reference names no known definition.
+type UnresolvedRefError struct {
+	Ref string
+}
+
+// MaxDepthExceededError is returned when nested schema references exceed the maximum depth.
+type MaxDepthExceededError struct {
+	MaxDepth int
+}
+
+// Error returns the error message.
+func (e UnresolvedRefError) Error() string {
+	return fmt.Sprintf("unresolved schema reference %q", e.Ref)
+}
+
+// Error returns the error message.
+func (e MaxDepthExceededError) Error() string {
+	return fmt.Sprintf("maximum depth %d exceeded", e.MaxDepth)
+}
+
+// Ref creates a schema that refers to a named definition.
+// The name is resolved lazily during validation against the definitions
+// declared with Define on the enclosing schemas, which makes recursive
+// structures such as trees possible.
+//
+// Example:
+//
+//	schema := Ref("node").Define("node", Object(map[string]*Schema{
+//	    "name":     NewSchema(IsStringValidator).Required(),
+//	    "children": Array(Ref("node").Required()),
+//	}))
+func Ref(name string) *Schema {
+	return &Schema{
+		Extra: ExtraIgnore,
+		ref:   name,
+	}
+}
+
+// Define registers a named schema that can be referenced with Ref from this
+// schema and anything nested inside it. Inner definitions shadow outer ones.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	schema := Object(map[string]*Schema{
+//	    "root": Ref("category").Required(),
+//	}).Define("category", Object(map[string]*Schema{
+//	    "title":         NewSchema(IsStringValidator).Required(),
+//	    "subcategories": Array(Ref("category").Required()),
+//	}))
+func (s *Schema) Define(name string, schema *Schema) *Schema {
+	if s.definitions == nil {
+		s.definitions = make(map[string]*Schema)
+	}
+	s.definitions[name] = schema
+	return s
+}
+
+// WithMaxDepth sets how many schema references may be expanded along a single path.
+// Deeper values are reported with a MaxDepthExceededError.
+// Returns the validator for method chaining.
+//
+// Example:
+//
+//	validator := NewSchemaValidator(PathPresenter("."), SimpleErrorPresenter()).WithMaxDepth(20)
+func (sv *SchemaValidator) WithMaxDepth(maxDepth int) *SchemaValidator {
+	sv.maxDepth = maxDepth
+	return sv
+}
+
+// with returns a scope extended with the given definitions.
+func (sc *schemaScope) with(definitions map[string]*Schema) *schemaScope {
+	if len(definitions) == 0 {
+		return sc
+	}
+	return &schemaScope{
+		definitions: definitions,
+		parent:      sc,
+	}
+}
+
+// lookup resolves a definition by name, searching from the innermost scope outwards.
+func (sc *schemaScope) lookup(name string) (*Schema, bool) {
+	for scope := sc; scope != nil; scope = scope.parent {
+		if schema, ok := scope.definitions[name]; ok {
+			return schema, true
+		}
+	}
+	return nil, false
+}
+
+// enterScope returns a context whose scope includes the schema's definitions.
+func (sv *SchemaValidator) enterScope(valCtx *ValidationContext, schema *Schema) *ValidationContext {
+	if len(schema.definitions) == 0 {
+		return valCtx
+	}
+	child := *valCtx
+	child.scope = valCtx.scope.with(schema.definitions)
+	return &child
+}
+
+// validateRef resolves a schema reference and validates the value against its target.
+// Returns the value as normalized by the target.
+func (sv *SchemaValidator) validateRef(valCtx *ValidationContext, value any, schema *Schema) any {
+	target, ok := valCtx.scope.lookup(schema.ref)
+	if !ok {
+		valCtx.errorCollector.Collect(valCtx.path, UnresolvedRefError{Ref: schema.ref})
+		return value
+	}
+
+	if !sv.validateRequired(valCtx, value, schema) {
+		return value
+	}
+
+	maxDepth := sv.maxDepth
+	if maxDepth <= 0 {
+		maxDepth = DefaultMaxDepth
+	}
+	if valCtx.depth >= maxDepth {
+		valCtx.errorCollector.Collect(valCtx.path, MaxDepthExceededError{MaxDepth: maxDepth})
+		return value
+	}
+
+	child := *valCtx
+	child.depth++
+	return sv.validateValue(&child, value, target)
+}
+
+// delegateValidator wraps a schema into a ContextValidator that runs the SchemaValidator.
+// It lets the legacy Definition format express what it has no native support for,
+// such as recursive references. Nested errors are returned as PathError values
+// relative to the validated value.
+func delegateValidator(schema *Schema, scope *schemaScope) ContextValidator {
+	return func(ctx context.Context, value any) (twigBlock bool, errs []error) {
+		collector := &recordingCollector{}
+		valCtx := &ValidationContext{
+			ctx:            ctx,
+			errorCollector: collector,
+			scope:          scope,
+		}
+		NewSchemaValidator(PathPresenter("."), SimpleErrorPresenter()).validateValue(valCtx, value, schema)
+
+		for _, err := range collector.errors {
+			errs = append(errs, err)
+		}
+		return len(errs) > 0, errs
+	}
+}
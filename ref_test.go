@@ -0,0 +1,176 @@
+package govalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func treeSchema() *govalidator.Schema {
+	return govalidator.Ref("node").Required().Define("node", govalidator.Object(map[string]*govalidator.Schema{
+		"name":     govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		"children": govalidator.Array(govalidator.Ref("node").Required()),
+	}))
+}
+
+func TestRef(t *testing.T) {
+	tree := map[string]any{
+		"name": "root",
+		"children": []any{
+			map[string]any{"name": "a"},
+			map[string]any{
+				"name": "b",
+				"children": []any{
+					map[string]any{"name": 1},
+				},
+			},
+		},
+	}
+
+	t.Run("validates recursive structures", func(t *testing.T) {
+		valid, errs := treeSchema().Validate(context.Background(), tree)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$.children[1].children[0].name": {"not a string"},
+		}, errs)
+	})
+
+	t.Run("required reference rejects nil", func(t *testing.T) {
+		valid, errs := treeSchema().Validate(context.Background(), nil)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"required"}}, errs)
+	})
+
+	t.Run("optional reference accepts nil", func(t *testing.T) {
+		schema := govalidator.Ref("s").Define("s", govalidator.NewSchema(govalidator.IsStringValidator))
+
+		valid, errs := schema.Validate(context.Background(), nil)
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("reports unresolved references", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"child": govalidator.Ref("missing"),
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"child": "x"})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{`$.child`: {`unresolved schema reference "missing"`}}, errs)
+	})
+
+	t.Run("inner definitions shadow outer ones", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"outer": govalidator.Ref("v"),
+			"inner": govalidator.Object(map[string]*govalidator.Schema{
+				"value": govalidator.Ref("v"),
+			}).Define("v", govalidator.NewSchema(govalidator.IsIntegerValidator)),
+		}).Define("v", govalidator.NewSchema(govalidator.IsStringValidator))
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{
+			"outer": "text",
+			"inner": map[string]any{"value": 3},
+		})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("stops at maximum depth", func(t *testing.T) {
+		validator := govalidator.NewSchemaValidator(
+			govalidator.PathPresenter("."),
+			govalidator.SimpleErrorPresenter(),
+		).WithMaxDepth(2)
+
+		valid, errs := validator.Validate(context.Background(), tree, treeSchema())
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$.children[1].children[0]": {"maximum depth 2 exceeded"},
+		}, errs)
+	})
+
+	t.Run("zero max depth falls back to default", func(t *testing.T) {
+		validator := govalidator.NewSchemaValidator(
+			govalidator.PathPresenter("."),
+			govalidator.SimpleErrorPresenter(),
+		).WithMaxDepth(0)
+
+		valid, _ := validator.Validate(context.Background(), map[string]any{"name": "root"}, treeSchema())
+
+		assert.True(t, valid)
+	})
+}
+
+func TestRef_ToDefinition(t *testing.T) {
+	validate := func(schema *govalidator.Schema, value any) map[string][]string {
+		_, errs := govalidator.NewBasicValidator(
+			govalidator.PathPresenter("."),
+			govalidator.SimpleErrorPresenter(),
+		).Validate(context.Background(), value, schema.ToDefinition())
+		return errs
+	}
+
+	t.Run("recursive references do not loop", func(t *testing.T) {
+		errs := validate(treeSchema(), map[string]any{
+			"name": "root",
+			"children": []any{
+				map[string]any{"name": "a", "children": []any{map[string]any{}}},
+			},
+		})
+
+		assert.Equal(t, map[string][]string{
+			"$.children[0]": {"children[0].name: required"},
+		}, errs)
+	})
+
+	t.Run("non-recursive references are expanded", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"email": govalidator.Ref("email").Required(),
+		}).Define("email", govalidator.NewSchema(govalidator.IsStringValidator))
+
+		def := schema.ToDefinition()
+
+		assert.Len(t, (*def.Fields)["email"].Validator, 3)
+		assert.Equal(t, map[string][]string{"$": {"field email not defined"}}, validate(schema, map[string]any{}))
+		assert.Equal(t, map[string][]string{"$.email": {"not a string"}}, validate(schema, map[string]any{"email": 1}))
+	})
+
+	t.Run("unresolved references are delegated", func(t *testing.T) {
+		errs := validate(govalidator.Ref("missing"), "x")
+
+		assert.Equal(t, map[string][]string{"$": {`unresolved schema reference "missing"`}}, errs)
+	})
+
+	t.Run("combinators are delegated", func(t *testing.T) {
+		schema := govalidator.AnyOf(
+			govalidator.NewSchema(govalidator.IsStringValidator),
+		)
+
+		assert.Empty(t, validate(schema, "x"))
+		assert.Equal(t, map[string][]string{
+			"$": {"value does not match any of 1 schemas; [0] not a string"},
+		}, validate(schema, 1))
+	})
+}
+
+func TestRefErrors_Presenters(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, "schema 'node' is not defined",
+		govalidator.DetailedErrorPresenter()(ctx, nil, govalidator.UnresolvedRefError{Ref: "node"}))
+	assert.Equal(t, "value is nested too deeply (at most 5 levels allowed)",
+		govalidator.DetailedErrorPresenter()(ctx, nil, govalidator.MaxDepthExceededError{MaxDepth: 5}))
+	assert.Contains(t,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$"}, govalidator.UnresolvedRefError{Ref: "node"}),
+		`"ref":"node"`)
+	assert.Contains(t,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$"}, govalidator.MaxDepthExceededError{MaxDepth: 5}),
+		`"maxDepth":5`)
+}
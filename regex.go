@@ -18,7 +18,7 @@ func (e ValueNotMatchingPatternError) Error() string {
 
 // RegexpValidator validates that a string value matches the given regular expression pattern.
 func RegexpValidator(pattern regexp.Regexp) ContextValidator {
-	return func(_ context.Context, value any) (twigBlock bool, errs []error) {
+	return describe(func(_ context.Context, value any) (twigBlock bool, errs []error) {
 		// we can't proceed if the value is not a string
 		if _, ok := value.(string); !ok {
 			return true, []error{NotAStringError{}}
@@ -29,5 +29,5 @@ func RegexpValidator(pattern regexp.Regexp) ContextValidator {
 		}
 
 		return
-	}
+	}, builtinInfo("regex", pattern.String()))
 }
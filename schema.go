@@ -36,6 +36,86 @@ type Schema struct {
 	// required tracks if this value must be non-null
 	// Use Required() and Optional() methods to set this
 	required bool
+
+	// discriminator selects an additional field set based on a tag field
+	// Use Discriminator() to set this
+	discriminator *discriminator
+
+	// anyOf, exactlyOneOf and allOf hold combinator branches
+	// Use AnyOf(), ExactlyOneOf() and AllOf() to create combinator schemas
+	anyOf        []*Schema
+	exactlyOneOf []*Schema
+	allOf        []*Schema
+
+	// ref names a definition this schema refers to
+	// Use Ref() to create reference schemas
+	ref string
+
+	// definitions holds named schemas available to references
+	// Use Define() to add definitions
+	definitions map[string]*Schema
+
+	// values is applied to the values of keys not listed in Fields
+	// Use Values() to set this
+	values *Schema
+
+	// keys are applied to every key name of an object
+	// Use Keys() to set this
+	keys []ContextValidator
+
+	// patternFields attach schemas to keys matching regular expressions
+	// Use PatternField() to add these
+	patternFields []patternField
+
+	// prefixItems defines positional schemas for tuple arrays
+	// Use Tuple() to create tuple schemas
+	prefixItems []*Schema
+
+	// closedTuple rejects items beyond prefixItems
+	// Use NoAdditionalItems() to set this
+	closedTuple bool
+
+	// rules validate relationships between fields of an object
+	// Use WithRules() to add these
+	rules []ObjectRule
+
+	// presentKeys are names that must be present in an object without declaring a field,
+	// so that they are still subject to the Extra mode. Set by FromJSONSchema for
+	// "required" names missing from "properties".
+	presentKeys []string
+
+	// keyConditions are conditional requirements of names that are not declared as fields.
+	// Set by FromJSONSchema for "dependentRequired" names missing from "properties".
+	keyConditions []keyCondition
+
+	// conditions make a field's presence depend on other fields of its object
+	// Use RequiredIf(), RequiredUnless(), RequiredWith(), RequiredWithout() and MutuallyExclusive() to add these
+	conditions []fieldCondition
+
+	// ifSchema, thenSchema and elseSchema select a schema by a silently evaluated condition
+	// Use If(), Then() and Else() to set these
+	ifSchema   *Schema
+	thenSchema *Schema
+	elseSchema *Schema
+
+	// mustBePresent requires the field to exist in its object, null or not
+	// Use Present() to set this
+	mustBePresent bool
+
+	// notNull rejects an explicit null without requiring presence
+	// Use NotNull() and Nullable() to set this
+	notNull bool
+
+	// defaultFunc computes the value used when the field is absent from its object
+	// Use Default() or DefaultFunc() to set this
+	defaultFunc func(ctx context.Context) any
+
+	// staticDefault marks a defaultFunc set by Default, which always returns the same value
+	staticDefault bool
+
+	// transformers convert the value before validators run
+	// Use WithTransformers() to set this
+	transformers []Transformer
 }
 
 // Field represents a field definition with its name and schema.
@@ -243,9 +323,57 @@ func (s *Schema) IsRequired() bool {
 	return s.required
 }
 
+// isObject reports whether this schema describes an object.
+func (s *Schema) isObject() bool {
+	return s.Fields != nil || s.discriminator != nil || s.values != nil || s.keys != nil ||
+		s.patternFields != nil || s.rules != nil || s.presentKeys != nil || s.keyConditions != nil
+}
+
+// needsDelegate reports whether the schema uses features the legacy Definition format cannot express.
+func (s *Schema) needsDelegate() bool {
+	return s.discriminator != nil ||
+		len(s.anyOf) > 0 || len(s.exactlyOneOf) > 0 || len(s.allOf) > 0 ||
+		s.values != nil || s.keys != nil || s.patternFields != nil ||
+		s.prefixItems != nil || s.rules != nil || s.presentKeys != nil || s.keyConditions != nil || s.hasDelegatedFields() ||
+		s.ifSchema != nil || s.transformers != nil
+}
+
+// hasDelegatedFields reports whether any field of the schema has presence rules
+// that are evaluated by the enclosing object.
+func (s *Schema) hasDelegatedFields() bool {
+	for _, field := range s.Fields {
+		if field == nil {
+			continue
+		}
+		if len(field.conditions) > 0 || field.mustBePresent || field.notNull || field.defaultFunc != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // ToDefinition converts a Schema to the legacy Definition format.
 // This provides backward compatibility during migration to the new API.
+// Features the legacy format cannot express natively, such as recursive
+// references, discriminators, combinators and map schemas, are delegated to a SchemaValidator
+// wrapped in a ContextValidator.
 func (s *Schema) ToDefinition() Definition {
+	return s.toDefinition(nil, map[*Schema]bool{})
+}
+
+// toDefinition converts the schema within the given definition scope.
+// visiting tracks referenced schemas on the current path to break cycles.
+func (s *Schema) toDefinition(scope *schemaScope, visiting map[*Schema]bool) Definition {
+	scope = scope.with(s.definitions)
+
+	if s.ref != "" {
+		return s.refToDefinition(scope, visiting)
+	}
+
+	if s.needsDelegate() {
+		return Definition{Validator: []ContextValidator{delegateValidator(s, scope)}}
+	}
+
 	def := Definition{
 		Validator: make([]ContextValidator, len(s.Validators)),
 	}
@@ -262,7 +390,7 @@ func (s *Schema) ToDefinition() Definition {
 	if s.Fields != nil {
 		fieldDefs := make(map[string]Definition)
 		for name, fieldSchema := range s.Fields {
-			fieldDefs[name] = fieldSchema.ToDefinition()
+			fieldDefs[name] = fieldSchema.toDefinition(scope, visiting)
 		}
 		def.Fields = &fieldDefs
 		def.AcceptExtraProperty = s.Extra == ExtraIgnore
@@ -271,13 +399,31 @@ func (s *Schema) ToDefinition() Definition {
 
 	// Handle items (array validation)
 	if s.Items != nil {
-		itemDef := s.Items.ToDefinition()
+		itemDef := s.Items.toDefinition(scope, visiting)
 		def.ListOf = &itemDef
 	}
 
 	return def
 }
 
+// refToDefinition expands a reference inline, or delegates to a SchemaValidator
+// when the reference is recursive or cannot be resolved.
+func (s *Schema) refToDefinition(scope *schemaScope, visiting map[*Schema]bool) Definition {
+	target, ok := scope.lookup(s.ref)
+	if !ok || visiting[target] {
+		return Definition{Validator: []ContextValidator{delegateValidator(s, scope)}}
+	}
+
+	visiting[target] = true
+	defer delete(visiting, target)
+
+	def := target.toDefinition(scope, visiting)
+	if s.required {
+		def.Validator = append([]ContextValidator{NonNullableValidator}, def.Validator...)
+	}
+	return def
+}
+
 // Validate validates a value against this schema using default presenters.
 // Uses the modern SchemaValidator for direct, non-legacy validation.
 //
@@ -0,0 +1,287 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SchemaFileError is returned by SchemaFromYAML and SchemaFromJSON for malformed schema files.
+type SchemaFileError struct {
+	Path   string
+	Reason string
+}
+
+// Error returns the error message.
+func (e SchemaFileError) Error() string {
+	return fmt.Sprintf("invalid schema file at %s: %s", e.Path, e.Reason)
+}
+
+// schemaFileKeys are the keys allowed in a schema file node.
+var schemaFileKeys = map[string]bool{
+	"type":        true,
+	"required":    true,
+	"extra":       true,
+	"fields":      true,
+	"items":       true,
+	"validators":  true,
+	"description": true,
+}
+
+// schemaFileExtraModes maps the values of "extra" to extra fields modes.
+var schemaFileExtraModes = map[string]ExtraFieldsMode{
+	"forbid": ExtraForbid,
+	"ignore": ExtraIgnore,
+}
+
+// SchemaFromYAML builds a schema from a YAML schema file. Validators are looked
+// up by name in the registry; a nil registry means DefaultValidatorRegistry.
+//
+// Each node of the file describes one schema with these keys, all optional:
+//
+//	type:        string, integer, number, boolean, object or array
+//	required:    true to reject null and absent values
+//	extra:       forbid or ignore (the default) for fields not listed
+//	fields:      a mapping of field names to nodes
+//	items:       the node of array items; implies type array
+//	validators:  a list of validator names, or of single-key mappings
+//	             from a name to its argument or list of arguments
+//	description: ignored
+//
+// Example:
+//
+//	type: object
+//	required: true
+//	extra: forbid
+//	fields:
+//	  username:
+//	    type: string
+//	    required: true
+//	    validators:
+//	      - min_length: 3
+//	      - regex: ^[a-z]+$
+//	  role:
+//	    validators:
+//	      - one_of: [admin, user]
+//	  tags:
+//	    items:
+//	      type: string
+//
+// Numbers are decoded as float64, like encoding/json does. one_of options
+// compare numbers by value, so they also match ints from native Go values.
+func SchemaFromYAML(data []byte, registry *ValidatorRegistry) (*Schema, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid schema file: %w", err)
+	}
+	return newSchemaFileLoader(registry).load(normalizeYAML(doc), "$")
+}
+
+// IsJSONSchema reports whether a schema document, in JSON or YAML, is a JSON
+// Schema rather than a schema file read by SchemaFromYAML and SchemaFromJSON:
+// it is a boolean schema, declares $schema, uses a key schema files do not
+// have, or lists required fields. Documents that cannot be decoded are not JSON Schema.
+//
+// Example:
+//
+//	if IsJSONSchema(data) {
+//	    schema, err = FromJSONSchema(data)
+//	} else {
+//	    schema, err = SchemaFromJSON(data, nil)
+//	}
+func IsJSONSchema(data []byte) bool {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return false
+	}
+	node, ok := normalizeYAML(doc).(map[string]any)
+	if !ok {
+		// Boolean schemas only exist in JSON Schema
+		_, isBool := doc.(bool)
+		return isBool
+	}
+	for key, value := range node {
+		if !schemaFileKeys[key] {
+			return true
+		}
+		if _, isBool := value.(bool); key == "required" && !isBool {
+			return true
+		}
+	}
+	return false
+}
+
+// SchemaFromJSON builds a schema from a JSON schema file, the JSON form of the
+// format read by SchemaFromYAML.
+//
+// Example:
+//
+//	schema, err := SchemaFromJSON([]byte(`{
+//	    "type": "object",
+//	    "fields": {"email": {"type": "string", "required": true, "validators": ["email"]}}
+//	}`), nil)
+func SchemaFromJSON(data []byte, registry *ValidatorRegistry) (*Schema, error) {
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid schema file: %w", err)
+	}
+	return newSchemaFileLoader(registry).load(doc, "$")
+}
+
+// schemaFileLoader builds schemas from decoded schema file nodes.
+type schemaFileLoader struct {
+	registry *ValidatorRegistry
+}
+
+// newSchemaFileLoader creates a loader using the registry, or DefaultValidatorRegistry if it is nil.
+func newSchemaFileLoader(registry *ValidatorRegistry) *schemaFileLoader {
+	if registry == nil {
+		registry = DefaultValidatorRegistry
+	}
+	return &schemaFileLoader{registry: registry}
+}
+
+// load builds the schema of the node found at path.
+func (l *schemaFileLoader) load(value any, path string) (*Schema, error) {
+	node, ok := value.(map[string]any)
+	if !ok {
+		return nil, SchemaFileError{Path: path, Reason: "expected a mapping"}
+	}
+	for _, key := range sortedKeys(node) {
+		if !schemaFileKeys[key] {
+			return nil, SchemaFileError{Path: path, Reason: fmt.Sprintf("unknown key %q", key)}
+		}
+	}
+
+	s := NewSchema()
+
+	if typeName, ok := node["type"]; ok {
+		validator, known := jsonSchemaTypes[fmt.Sprint(typeName)]
+		if !known {
+			return nil, SchemaFileError{Path: path + ".type", Reason: fmt.Sprintf("unknown type %v", typeName)}
+		}
+		s.Validators = append(s.Validators, validator)
+	}
+
+	if required, ok := node["required"]; ok {
+		isRequired, isBool := required.(bool)
+		if !isBool {
+			return nil, SchemaFileError{Path: path + ".required", Reason: "expected a boolean"}
+		}
+		if isRequired {
+			s.Required()
+		}
+	}
+
+	if extra, ok := node["extra"]; ok {
+		mode, known := schemaFileExtraModes[fmt.Sprint(extra)]
+		if !known {
+			return nil, SchemaFileError{Path: path + ".extra", Reason: fmt.Sprintf("expected forbid or ignore, got %v", extra)}
+		}
+		s.WithExtra(mode)
+	}
+
+	if err := l.loadValidators(s, node["validators"], path+".validators"); err != nil {
+		return nil, err
+	}
+
+	if fields, ok := node["fields"]; ok {
+		fieldNodes, isMap := fields.(map[string]any)
+		if !isMap {
+			return nil, SchemaFileError{Path: path + ".fields", Reason: "expected a mapping"}
+		}
+		s.Fields = make(map[string]*Schema, len(fieldNodes))
+		for _, name := range sortedKeys(fieldNodes) {
+			field, err := l.load(fieldNodes[name], path+".fields."+name)
+			if err != nil {
+				return nil, err
+			}
+			s.Fields[name] = field
+		}
+	}
+
+	if items, ok := node["items"]; ok {
+		item, err := l.load(items, path+".items")
+		if err != nil {
+			return nil, err
+		}
+		if _, typed := node["type"]; !typed {
+			// Like Array, items make the schema an array schema
+			s.Validators = append([]ContextValidator{IsListValidator}, s.Validators...)
+		}
+		s.Items = item
+	}
+
+	return s, nil
+}
+
+// loadValidators creates the validators listed under "validators" from the registry.
+func (l *schemaFileLoader) loadValidators(s *Schema, value any, path string) error {
+	if value == nil {
+		return nil
+	}
+	entries, ok := value.([]any)
+	if !ok {
+		return SchemaFileError{Path: path, Reason: "expected a list"}
+	}
+
+	for i, entry := range entries {
+		entryPath := fmt.Sprintf("%s[%d]", path, i)
+		name, args, err := validatorEntry(entry)
+		if err != nil {
+			return SchemaFileError{Path: entryPath, Reason: err.Error()}
+		}
+		validator, err := l.registry.New(name, args...)
+		if err != nil {
+			return SchemaFileError{Path: entryPath, Reason: err.Error()}
+		}
+		s.Validators = append(s.Validators, validator)
+	}
+	return nil
+}
+
+// validatorEntry splits a validator entry into its name and arguments.
+// An entry is either a name or a mapping from a name to its argument or list of arguments.
+func validatorEntry(entry any) (name string, args []any, err error) {
+	switch e := entry.(type) {
+	case string:
+		return e, nil, nil
+	case map[string]any:
+		if len(e) != 1 {
+			return "", nil, fmt.Errorf("expected a single validator name, got %d", len(e))
+		}
+		for name, value := range e {
+			switch v := value.(type) {
+			case nil:
+				return name, nil, nil
+			case []any:
+				return name, v, nil
+			default:
+				return name, []any{v}, nil
+			}
+		}
+	}
+	return "", nil, fmt.Errorf("expected a validator name or a mapping, got %v", entry)
+}
+
+// normalizeYAML converts the integers decoded by yaml.v3 to float64, as encoding/json decodes them.
+func normalizeYAML(value any) any {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeYAML(item)
+		}
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeYAML(item)
+		}
+	}
+	return value
+}
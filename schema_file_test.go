@@ -0,0 +1,209 @@
+package govalidator_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaFromYAML(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("objects", func(t *testing.T) {
+		schema, err := govalidator.SchemaFromYAML([]byte(`
+description: a user account
+type: object
+required: true
+extra: forbid
+fields:
+  username:
+    type: string
+    required: true
+    validators:
+      - min_length: 3
+      - max_length: 12
+      - regex: ^[a-z]+$
+  email:
+    type: string
+    validators: [email]
+  role:
+    validators:
+      - one_of: [admin, user]
+  age:
+    type: integer
+    validators:
+      - min_float: 18
+  tags:
+    items:
+      type: string
+    validators:
+      - max_size: [2, true]
+`), nil)
+		require.NoError(t, err)
+
+		valid, errs := schema.Validate(ctx, map[string]any{
+			"username": "ann", "email": "ann@example.com", "role": "admin", "age": 30.0, "tags": []any{"a"},
+		})
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+
+		_, errs = schema.Validate(ctx, map[string]any{
+			"username": "A1", "email": "ann", "role": "root", "age": 12.0, "tags": []any{"a", 1.0, "c"}, "other": 1,
+		})
+		assert.ElementsMatch(t, []string{"$", "$.username", "$.email", "$.role", "$.age", "$.tags"}, keys(errs))
+		assert.Equal(t, []string{"unexpected field other"}, errs["$"])
+		assert.Len(t, errs["$.username"], 2)
+
+		_, errs = schema.Validate(ctx, nil)
+		assert.Equal(t, []string{"$"}, keys(errs))
+	})
+
+	t.Run("numbers decode like JSON", func(t *testing.T) {
+		schema, err := govalidator.SchemaFromYAML([]byte(`validators: [{one_of: [1, 2.5]}]`), nil)
+		require.NoError(t, err)
+
+		valid, _ := schema.Validate(ctx, 1.0)
+		assert.True(t, valid)
+		valid, _ = schema.Validate(ctx, 2.5)
+		assert.True(t, valid)
+		valid, _ = schema.Validate(ctx, 3.0)
+		assert.False(t, valid)
+	})
+
+	t.Run("items imply an array", func(t *testing.T) {
+		schema, err := govalidator.SchemaFromYAML([]byte(`items: {type: integer}`), nil)
+		require.NoError(t, err)
+
+		valid, _ := schema.Validate(ctx, []any{1.0, 2.0})
+		assert.True(t, valid)
+		_, errs := schema.Validate(ctx, "a")
+		assert.Equal(t, []string{"$"}, keys(errs))
+		_, errs = schema.Validate(ctx, []any{"a"})
+		assert.Equal(t, []string{"$[0]"}, keys(errs))
+	})
+
+	t.Run("registered validators", func(t *testing.T) {
+		registry := govalidator.NewValidatorRegistry()
+		require.NoError(t, registry.Register("not", func(args []any) (govalidator.ContextValidator, error) {
+			return func(_ context.Context, value any) (bool, []error) {
+				if value == args[0] {
+					return false, []error{errors.New("forbidden value")}
+				}
+				return false, nil
+			}, nil
+		}))
+
+		schema, err := govalidator.SchemaFromYAML([]byte(`
+fields:
+  comment:
+    type: string
+    validators: [{not: darn}]
+`), registry)
+		require.NoError(t, err)
+
+		_, errs := schema.Validate(ctx, map[string]any{"comment": "darn"})
+		assert.Equal(t, map[string][]string{"$.comment": {"forbidden value"}}, errs)
+	})
+}
+
+func TestSchemaFromJSON(t *testing.T) {
+	schema, err := govalidator.SchemaFromJSON([]byte(`{
+		"type": "object",
+		"fields": {
+			"email": {"type": "string", "required": true, "validators": ["email"]},
+			"score": {"type": "number", "validators": [{"min_float": 0}, {"max_float": 10}, {"float": 1}]}
+		}
+	}`), nil)
+	require.NoError(t, err)
+
+	valid, _ := schema.Validate(context.Background(), map[string]any{"email": "ann@example.com", "score": 9.5})
+	assert.True(t, valid)
+
+	_, errs := schema.Validate(context.Background(), map[string]any{"email": "ann", "score": 10.25})
+	assert.ElementsMatch(t, []string{"$.email", "$.score"}, keys(errs))
+}
+
+func TestSchemaFromYAML_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{name: "non-mapping node", doc: `[]`, want: "invalid schema file at $: expected a mapping"},
+		{name: "unknown key", doc: `minLength: 3`, want: `invalid schema file at $: unknown key "minLength"`},
+		{name: "unknown type", doc: `type: decimal`, want: "invalid schema file at $.type: unknown type decimal"},
+		{name: "malformed required", doc: `required: yes`, want: "invalid schema file at $.required: expected a boolean"},
+		{name: "unknown extra mode", doc: `extra: allow`, want: "invalid schema file at $.extra: expected forbid or ignore, got allow"},
+		{name: "malformed fields", doc: `fields: [a]`, want: "invalid schema file at $.fields: expected a mapping"},
+		{name: "bad field", doc: "fields:\n  a:\n    type: x", want: "invalid schema file at $.fields.a.type: unknown type x"},
+		{name: "bad items", doc: `items: 1`, want: "invalid schema file at $.items: expected a mapping"},
+		{name: "malformed validators", doc: `validators: email`, want: "invalid schema file at $.validators: expected a list"},
+		{name: "unknown validator", doc: `validators: [is_email]`, want: `invalid schema file at $.validators[0]: unknown validator "is_email"`},
+		{name: "several names", doc: `validators: [{min_length: 1, max_length: 2}]`,
+			want: "invalid schema file at $.validators[0]: expected a single validator name, got 2"},
+		{name: "malformed entry", doc: `validators: [1]`, want: "invalid schema file at $.validators[0]: expected a validator name or a mapping, got 1"},
+		{name: "missing argument", doc: `validators: [min_length]`,
+			want: `invalid schema file at $.validators[0]: invalid arguments for validator "min_length": expected 1 argument, got 0`},
+		{name: "unexpected argument", doc: `validators: [{email: 1}]`,
+			want: `invalid schema file at $.validators[0]: invalid arguments for validator "email": expected no arguments, got 1`},
+		{name: "non-integer argument", doc: `validators: [email, {max_length: 1.5}]`,
+			want: `invalid schema file at $.validators[1]: invalid arguments for validator "max_length": expected a non-negative integer`},
+		{name: "bad pattern", doc: `validators: [{regex: "("}]`,
+			want: "invalid schema file at $.validators[0]: invalid arguments for validator \"regex\": error parsing regexp: missing closing ): `(`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := govalidator.SchemaFromYAML([]byte(tt.doc), nil)
+
+			assert.Nil(t, schema)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+
+	t.Run("invalid YAML", func(t *testing.T) {
+		_, err := govalidator.SchemaFromYAML([]byte("a: [b"), nil)
+
+		require.Error(t, err)
+		assert.True(t, strings.HasPrefix(err.Error(), "invalid schema file: yaml:"))
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := govalidator.SchemaFromJSON([]byte(`{`), nil)
+
+		assert.EqualError(t, err, "invalid schema file: unexpected end of JSON input")
+	})
+
+	t.Run("error type", func(t *testing.T) {
+		_, err := govalidator.SchemaFromJSON([]byte(`{"fields": {"a": {"validators": ["nope"]}}}`), nil)
+
+		assert.Equal(t, govalidator.SchemaFileError{Path: "$.fields.a.validators[0]", Reason: `unknown validator "nope"`}, err)
+	})
+}
+
+func TestIsJSONSchema(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want bool
+	}{
+		{name: "$schema", doc: `{"$schema": "https://json-schema.org/draft/2020-12/schema"}`, want: true},
+		{name: "JSON Schema keywords", doc: `{"type": "object", "properties": {}}`, want: true},
+		{name: "required lists", doc: "type: object\nrequired: [a]\n", want: true},
+		{name: "boolean schemas", doc: `true`, want: true},
+		{name: "schema files", doc: `{"type": "object", "required": true, "fields": {}}`},
+		{name: "YAML schema files", doc: "type: object\nfields:\n  name:\n    validators: [email]\n"},
+		{name: "malformed documents", doc: `{"type": [`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, govalidator.IsJSONSchema([]byte(tt.doc)))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package govalidator
 import (
 	"context"
 	"fmt"
+	"maps"
 	"sort"
 )
 
@@ -25,6 +26,7 @@ type ErrorCollector interface {
 type SchemaValidator struct {
 	pathPresenter  PresenterFunc
 	errorPresenter PresenterFunc
+	maxDepth       int
 }
 
 // ValidationContext holds state during validation traversal.
@@ -35,6 +37,9 @@ type ValidationContext struct {
 	errorCollector ErrorCollector
 	pathPresenter  PresenterFunc
 	errorPresenter PresenterFunc
+	scope          *schemaScope
+	depth          int
+	normalize      bool
 }
 
 // MapErrorCollector collects errors into a map[string][]string structure.
@@ -64,6 +69,7 @@ func NewSchemaValidator(pathPresenter PresenterFunc, errorPresenter PresenterFun
 	return &SchemaValidator{
 		pathPresenter:  pathPresenter,
 		errorPresenter: errorPresenter,
+		maxDepth:       DefaultMaxDepth,
 	}
 }
 
@@ -123,37 +129,73 @@ func (sv *SchemaValidator) ValidateFlat(
 }
 
 // validateValue is the core validation logic that handles a single value.
-func (sv *SchemaValidator) validateValue(valCtx *ValidationContext, value any, schema *Schema) {
+// Returns the value with defaults applied when the context normalizes,
+// and the value itself otherwise.
+func (sv *SchemaValidator) validateValue(valCtx *ValidationContext, value any, schema *Schema) any {
+	// Step 0: Bring native Go values into JSON shape, make definitions visible and resolve references
+	value = adaptValue(value)
+	valCtx = sv.enterScope(valCtx, schema)
+	if schema.ref != "" {
+		return sv.validateRef(valCtx, value, schema)
+	}
+
 	// Step 1: Check required/optional
 	if !sv.validateRequired(valCtx, value, schema) {
-		return // If required check fails, stop validation
+		return value // If required check fails, stop validation
 	}
 
 	// Step 2: If value is nil and optional, skip further validation
-	if value == nil && !schema.required {
-		return
+	if value == nil {
+		return value
+	}
+
+	// Step 3: Convert the value, then run all validators in order
+	value, ok := sv.runTransformers(valCtx, value, schema)
+	if !ok {
+		return value // If conversion fails, stop validation
 	}
 
-	// Step 3: Run all validators in order
 	if !sv.runValidators(valCtx, value, schema) {
-		return // If validator blocks, stop validation
+		return value // If validator blocks, stop validation
 	}
 
-	// Step 4: Handle nested structures
-	if schema.Fields != nil {
-		sv.validateObject(valCtx, value, schema)
-		return
+	// Step 4: Apply schema combinators and conditional branches
+	branches := sv.validateCombinators(valCtx, value, schema)
+	if branch, chosen := sv.validateIfThenElse(valCtx, value, schema); chosen && valCtx.normalize {
+		branches = append(branches, branch)
 	}
 
-	if schema.Items != nil {
-		sv.validateArray(valCtx, value, schema)
-		return
+	// Step 5: Handle nested structures
+	var normalized any
+	switch {
+	case schema.isObject():
+		normalized = sv.validateObject(valCtx, value, schema)
+	case schema.isArray():
+		normalized = sv.validateArray(valCtx, value, schema)
+	case valCtx.normalize:
+		normalized = cloneValue(value)
+	default:
+		return value
+	}
+
+	// Step 6: Keep what the matching branches normalized
+	for _, branch := range branches {
+		normalized = mergeNormalized(value, normalized, branch)
 	}
+	return normalized
 }
 
-// validateRequired checks if a required field is present.
+// validateRequired checks if a non-null value is present.
 func (sv *SchemaValidator) validateRequired(valCtx *ValidationContext, value any, schema *Schema) bool {
-	if schema.required && value == nil {
+	if value != nil {
+		return true
+	}
+
+	switch {
+	case schema.notNull:
+		valCtx.errorCollector.Collect(valCtx.path, NullNotAllowedError{})
+		return false
+	case schema.required:
 		valCtx.errorCollector.Collect(valCtx.path, RequiredError{})
 		return false
 	}
@@ -178,63 +220,216 @@ func (sv *SchemaValidator) runValidators(valCtx *ValidationContext, value any, s
 }
 
 // validateObject validates an object/map against field schemas.
-func (sv *SchemaValidator) validateObject(valCtx *ValidationContext, value any, schema *Schema) {
+// When normalizing, returns a copy of the object with normalized field values.
+func (sv *SchemaValidator) validateObject(valCtx *ValidationContext, value any, schema *Schema) any {
 	// Type check
 	currentMap, ok := value.(map[string]any)
 	if !ok || currentMap == nil {
 		valCtx.errorCollector.Collect(valCtx.path, NotAMapError{})
-		return
+		return value
+	}
+
+	// Normalized values are written to a copy, never to the input
+	var out map[string]any
+	if valCtx.normalize {
+		out = make(map[string]any, len(currentMap))
 	}
 
+	declared := make(map[string]bool, len(currentMap))
+	branches, resolved := sv.validateObjectFields(valCtx, currentMap, schema, declared, out)
+
+	// Selected discriminator branches take part in the object checks like the schema itself
+	objects := append([]objectSchema{{schema: schema, valCtx: valCtx}}, branches...)
+
+	// Check key names, then fields not covered by the schemas.
+	// If the field set is unknown, extra fields cannot be judged.
+	var rejected map[string]bool
+	for _, object := range objects {
+		keys := sv.validateKeys(object.valCtx, currentMap, object.schema)
+		if rejected == nil {
+			rejected = keys
+		} else {
+			maps.Copy(rejected, keys)
+		}
+	}
+	if resolved {
+		sv.validateExtraFields(valCtx, currentMap, objects, declared, rejected, out)
+	}
+
+	// Check names that must be present, then relationships between fields
+	for _, object := range objects {
+		sv.validatePresentKeys(object.valCtx, currentMap, object.schema)
+		sv.validateRules(object.valCtx, currentMap, object.schema)
+	}
+
+	if out == nil {
+		return value
+	}
+
+	// Keep fields the schema did not normalize
+	for key, item := range currentMap {
+		if _, done := out[key]; !done {
+			out[key] = cloneValue(item)
+		}
+	}
+
+	// Keep what the combinators and conditionals of selected branches normalized
+	var normalized any = out
+	for _, branch := range branches {
+		for _, branchValue := range branch.normalized {
+			normalized = mergeNormalized(currentMap, normalized, branchValue)
+		}
+	}
+	return normalized
+}
+
+// objectSchema is a schema applied to an object: the object's own schema or a
+// selected discriminator branch, with the context its definitions are visible in.
+type objectSchema struct {
+	schema *Schema
+	valCtx *ValidationContext
+
+	// normalized holds the values normalized by the branch's combinators and conditionals
+	normalized []any
+}
+
+// validateObjectFields validates the fields declared by the schema and, for
+// discriminated unions, by the selected branch. Every validated field name is
+// recorded in declared and, if out is not nil, its normalized value in out.
+// Returns the selected branches, outermost first, and false if the field set
+// could not be resolved.
+func (sv *SchemaValidator) validateObjectFields(
+	valCtx *ValidationContext,
+	currentMap map[string]any,
+	schema *Schema,
+	declared map[string]bool,
+	out map[string]any,
+) ([]objectSchema, bool) {
 	// Get sorted field names for consistent ordering
 	fieldNames := sv.getSortedFieldNames(schema)
 
 	// Validate each defined field
 	for _, fieldName := range fieldNames {
+		declared[fieldName] = true
 		fieldSchema := schema.Fields[fieldName]
 		fieldValue, exists := currentMap[fieldName]
 
-		if !exists {
-			// Field not present - validate as nil
-			childCtx := sv.pushPath(valCtx, fieldName)
-			sv.validateValue(childCtx, nil, fieldSchema)
-			continue
+		childCtx := sv.pushPath(valCtx, fieldName)
+		sv.validateConditions(childCtx, fieldName, fieldValue, currentMap, fieldSchema)
+		normalized, present := sv.validateField(childCtx, fieldName, fieldValue, exists, fieldSchema)
+		if out != nil && present {
+			out[fieldName] = normalized
 		}
+	}
 
-		// Field present - validate its value
-		childCtx := sv.pushPath(valCtx, fieldName)
-		sv.validateValue(childCtx, fieldValue, fieldSchema)
+	if schema.discriminator != nil {
+		return sv.validateDiscriminator(valCtx, currentMap, schema.discriminator, declared, out)
 	}
 
-	// Check for extra fields
-	sv.validateExtraFields(valCtx, currentMap, schema)
+	return nil, true
 }
 
-// validateArray validates an array against item schema.
-func (sv *SchemaValidator) validateArray(valCtx *ValidationContext, value any, schema *Schema) {
+// validateArray validates an array against positional and item schemas.
+// When normalizing, returns a copy of the array with normalized items.
+func (sv *SchemaValidator) validateArray(valCtx *ValidationContext, value any, schema *Schema) any {
 	// Type check
 	list, ok := value.([]any)
 	if !ok || list == nil {
 		valCtx.errorCollector.Collect(valCtx.path, NotAListError{})
-		return
+		return value
+	}
+
+	if schema.prefixItems != nil {
+		sv.validateTupleLength(valCtx, list, schema)
+	}
+
+	var out []any
+	if valCtx.normalize {
+		out = make([]any, len(list))
 	}
 
 	// Validate each item
 	for i, item := range list {
+		itemSchema := schema.Items
+		if i < len(schema.prefixItems) {
+			itemSchema = schema.prefixItems[i]
+		}
+		if itemSchema == nil {
+			if out != nil {
+				out[i] = cloneValue(item)
+			}
+			continue
+		}
+
 		childCtx := sv.pushPath(valCtx, fmt.Sprintf("[%d]", i))
-		sv.validateValue(childCtx, item, schema.Items)
+		normalized := sv.validateValue(childCtx, item, itemSchema)
+		if out != nil {
+			out[i] = normalized
+		}
+	}
+
+	if out == nil {
+		return value
 	}
+	return out
 }
 
-// validateExtraFields checks for unexpected fields in objects.
-func (sv *SchemaValidator) validateExtraFields(valCtx *ValidationContext, currentMap map[string]any, schema *Schema) {
-	if schema.Extra == ExtraIgnore {
+// validateExtraFields validates fields not declared by the schemas against matching
+// pattern schemas or the value schema, or checks them for being unexpected.
+// Patterns of every schema apply; the value schema of the innermost schema that has
+// one is used, and the Extra mode of the outermost schema decides about the rest.
+// Keys in rejected are skipped. Normalized values are stored in out if it is not nil.
+func (sv *SchemaValidator) validateExtraFields(
+	valCtx *ValidationContext,
+	currentMap map[string]any,
+	objects []objectSchema,
+	declared map[string]bool,
+	rejected map[string]bool,
+	out map[string]any,
+) {
+	extra := objects[0].schema.Extra
+	var values *objectSchema
+	hasPatterns := false
+	for i, object := range objects {
+		if object.schema.values != nil {
+			values = &objects[i]
+		}
+		hasPatterns = hasPatterns || object.schema.patternFields != nil
+	}
+	if extra == ExtraIgnore && values == nil && !hasPatterns {
 		return
 	}
 
 	// Find fields not in schema
-	for fieldName := range currentMap {
-		if _, defined := schema.Fields[fieldName]; !defined {
+	for _, fieldName := range sortedKeys(currentMap) {
+		if declared[fieldName] || rejected[fieldName] {
+			continue
+		}
+
+		value, matched := currentMap[fieldName], false
+		for _, object := range objects {
+			normalized, ok := sv.validatePatternFields(object.valCtx, fieldName, value, object.schema)
+			if ok {
+				value, matched = normalized, true
+			}
+		}
+		if matched {
+			if out != nil {
+				out[fieldName] = value
+			}
+			continue
+		}
+
+		if values != nil {
+			childCtx := sv.pushPath(values.valCtx, fieldName)
+			normalized := sv.validateValue(childCtx, value, values.schema.values)
+			if out != nil {
+				out[fieldName] = normalized
+			}
+			continue
+		}
+
+		if extra == ExtraForbid {
 			valCtx.errorCollector.Collect(valCtx.path, UnexpectedFieldError{
 				Field: fieldName,
 			})
@@ -264,6 +459,9 @@ func (sv *SchemaValidator) pushPath(parent *ValidationContext, segment string) *
 		errorCollector: parent.errorCollector,
 		pathPresenter:  parent.pathPresenter,
 		errorPresenter: parent.errorPresenter,
+		scope:          parent.scope,
+		depth:          parent.depth,
+		normalize:      parent.normalize,
 	}
 }
 
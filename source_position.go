@@ -0,0 +1,313 @@
+package govalidator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// SourcePosition locates a byte of a source document.
+// Offset starts at 0; Line and Column start at 1, and Column counts bytes.
+type SourcePosition struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+// String returns the position as "line:column".
+func (p SourcePosition) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// LocatedError attaches the source position of the offending value to a
+// validation error. It is created by ValidateJSON; presenters present Err and
+// may add the position.
+type LocatedError struct {
+	Err      error
+	Position SourcePosition
+
+	// Length is the number of bytes of the value on its first line
+	Length int
+
+	// Line is the source line containing the position, without the line break
+	Line string
+}
+
+// Error returns the message of the wrapped error.
+func (e LocatedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e LocatedError) Unwrap() error {
+	return e.Err
+}
+
+// Snippet returns the source line with the value underlined by carets.
+//
+// Example:
+//
+//	118 |       "port": 99999,
+//	    |               ^^^^^
+func (e LocatedError) Snippet() string {
+	gutter := fmt.Sprintf("%d", e.Position.Line)
+	prefix := e.Line[:max(min(e.Position.Column-1, len(e.Line)), 0)]
+	value := e.Line[len(prefix):min(len(prefix)+e.Length, len(e.Line))]
+
+	// Keep tabs so that the carets line up with the source
+	var indent strings.Builder
+	for _, r := range prefix {
+		if r == '\t' {
+			indent.WriteRune('\t')
+		} else {
+			indent.WriteRune(' ')
+		}
+	}
+	carets := strings.Repeat("^", max(utf8.RuneCountInString(value), 1))
+
+	return fmt.Sprintf("%s | %s\n%s | %s%s", gutter, e.Line, strings.Repeat(" ", len(gutter)), indent.String(), carets)
+}
+
+// unlocated returns the error wrapped by a LocatedError, or err itself.
+func unlocated(err error) error {
+	if located, ok := err.(LocatedError); ok { //nolint:errorlint // Only the outermost error is located
+		return located.Err
+	}
+	return err
+}
+
+// SourcePresenter creates a presenter that prefixes errors located by
+// ValidateJSON with the file name and position, and optionally follows them
+// with a snippet of the source line. Errors without a position get the file
+// name only.
+//
+// Example output: "config.json:118:17: $.servers[3].port: not an integer"
+func SourcePresenter(fileName string, snippet bool) PresenterFunc {
+	combined := CombinedPresenter(".", ": ")
+
+	return func(ctx context.Context, path []string, err error) string {
+		var located LocatedError
+		if !errors.As(err, &located) {
+			return fileName + ": " + combined(ctx, path, err)
+		}
+
+		out := fmt.Sprintf("%s:%s: %s", fileName, located.Position, combined(ctx, path, err))
+		if snippet {
+			out += "\n" + located.Snippet()
+		}
+		return out
+	}
+}
+
+// ValidateJSON decodes JSON data, validates it against the schema and returns
+// errors as a flat list like ValidateFlat. Every error given to the combiner
+// is a LocatedError holding the position of the value it is about, or of the
+// closest enclosing value for fields that are missing. Invalid JSON is
+// reported as an InvalidJSONError at the position of the syntax error.
+//
+// Example:
+//
+//	valid, errs := validator.ValidateJSON(ctx, data, schema, SourcePresenter("config.json", true))
+//	// errs: ["config.json:3:11: $.port: not an integer\n3 |   \"port\": 80.5\n  |           ^^^^"]
+func (sv *SchemaValidator) ValidateJSON(
+	ctx context.Context,
+	data []byte,
+	schema *Schema,
+	combiner PresenterFunc,
+) (bool, []string) {
+	collector := NewFlatErrorCollector(ctx, combiner)
+
+	source := newSourceMap(data)
+	value, err := source.decode()
+	if err != nil {
+		collector.Collect([]string{"$"}, err)
+		return false, collector.GetFlatErrors()
+	}
+
+	valCtx := &ValidationContext{
+		ctx:            ctx,
+		path:           []string{"$"},
+		errorCollector: &locatingCollector{ErrorCollector: collector, source: source},
+		pathPresenter:  sv.pathPresenter,
+		errorPresenter: sv.errorPresenter,
+	}
+
+	sv.validateValue(valCtx, value, schema)
+
+	return !collector.HasErrors(), collector.GetFlatErrors()
+}
+
+// ValidateJSON decodes JSON data and validates it against the schema,
+// giving the combiner errors located in the source.
+//
+// Example:
+//
+//	valid, errs := schema.ValidateJSON(ctx, data, SourcePresenter("config.json", false))
+//	// errs is []string: ["config.json:3:11: $.port: not an integer"]
+func (s *Schema) ValidateJSON(ctx context.Context, data []byte, combiner PresenterFunc) (bool, []string) {
+	v := NewSchemaValidator(PathPresenter("."), SimpleErrorPresenter())
+	return v.ValidateJSON(ctx, data, s, combiner)
+}
+
+// locatingCollector wraps errors in a LocatedError before collecting them.
+type locatingCollector struct {
+	ErrorCollector
+	source *sourceMap
+}
+
+// Collect adds an error at the given path.
+func (c *locatingCollector) Collect(path []string, err error) {
+	c.ErrorCollector.Collect(path, c.source.locate(path, err))
+}
+
+// sourceSpan is the byte range of a value in the source.
+type sourceSpan struct {
+	start int
+	end   int
+}
+
+// sourceMap records where each value of a JSON document is.
+type sourceMap struct {
+	data       []byte
+	decoder    *json.Decoder
+	lineStarts []int
+	spans      map[string]sourceSpan
+}
+
+// newSourceMap prepares a source map for decoding data.
+func newSourceMap(data []byte) *sourceMap {
+	lineStarts := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+	return &sourceMap{data: data, lineStarts: lineStarts, spans: map[string]sourceSpan{}}
+}
+
+// decode decodes the document like json.Unmarshal, recording the span of every value.
+// Syntax errors are returned as a LocatedError wrapping an InvalidJSONError.
+func (m *sourceMap) decode() (any, error) {
+	m.decoder = json.NewDecoder(bytes.NewReader(m.data))
+	value, err := m.value([]string{"$"})
+	end := int(m.decoder.InputOffset())
+	if err == nil {
+		if _, tokenErr := m.decoder.Token(); !errors.Is(tokenErr, io.EOF) {
+			// Something follows the top-level value
+			offset := m.skipSpace(end)
+			return nil, m.located(InvalidJSONError{Value: string(m.data)}, sourceSpan{start: offset, end: offset + 1})
+		}
+	}
+	if err != nil {
+		// Point at the offending byte, or at the end of truncated input
+		offset := m.skipSpace(end)
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) && offset < len(m.data) {
+			// The offending byte is the last one read
+			offset = int(syntaxErr.Offset) - 1
+		}
+		return nil, m.located(InvalidJSONError{Value: string(m.data)}, sourceSpan{start: offset, end: offset + 1})
+	}
+	return value, nil
+}
+
+// value decodes the next value, found at path.
+func (m *sourceMap) value(path []string) (any, error) {
+	start := m.skipSpace(int(m.decoder.InputOffset()))
+	token, err := m.decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	switch token {
+	case json.Delim('{'):
+		object := map[string]any{}
+		for m.decoder.More() {
+			key, err := m.decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			name, _ := key.(string)
+			if object[name], err = m.value(append(path[:len(path):len(path)], name)); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := m.decoder.Token(); err != nil {
+			return nil, err
+		}
+		value = object
+	case json.Delim('['):
+		list := []any{}
+		for i := 0; m.decoder.More(); i++ {
+			item, err := m.value(append(path[:len(path):len(path)], fmt.Sprintf("[%d]", i)))
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		if _, err := m.decoder.Token(); err != nil {
+			return nil, err
+		}
+		value = list
+	default:
+		value = token
+	}
+
+	m.spans[sourceKey(path)] = sourceSpan{start: start, end: int(m.decoder.InputOffset())}
+	return value, nil
+}
+
+// skipSpace returns the offset of the first byte at or after offset that is
+// not whitespace or a separator consumed along with the next token.
+func (m *sourceMap) skipSpace(offset int) int {
+	for offset < len(m.data) {
+		switch m.data[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// locate wraps err in a LocatedError at the value of path, or of its closest
+// recorded ancestor. Paths outside the document leave err unchanged.
+func (m *sourceMap) locate(path []string, err error) error {
+	for n := len(path); n > 0; n-- {
+		if span, ok := m.spans[sourceKey(path[:n])]; ok {
+			return m.located(err, span)
+		}
+	}
+	return err
+}
+
+// located wraps err in a LocatedError for the span.
+func (m *sourceMap) located(err error, span sourceSpan) LocatedError {
+	line := sort.Search(len(m.lineStarts), func(i int) bool { return m.lineStarts[i] > span.start }) - 1
+	lineStart := m.lineStarts[line]
+	lineEnd := len(m.data)
+	if line+1 < len(m.lineStarts) {
+		lineEnd = m.lineStarts[line+1] - 1
+	}
+	text := strings.TrimSuffix(string(m.data[lineStart:lineEnd]), "\r")
+
+	return LocatedError{
+		Err:      err,
+		Position: SourcePosition{Offset: span.start, Line: line + 1, Column: span.start - lineStart + 1},
+		Length:   max(min(span.end, lineStart+len(text))-span.start, 0),
+		Line:     text,
+	}
+}
+
+// sourceKey joins path segments into a source map key.
+func sourceKey(path []string) string {
+	return strings.Join(path, "\x00")
+}
@@ -0,0 +1,193 @@
+package govalidator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectLocated returns a combiner that keeps the errors it is given.
+func collectLocated(errs *[]govalidator.LocatedError) govalidator.PresenterFunc {
+	return func(_ context.Context, _ []string, err error) string {
+		var located govalidator.LocatedError
+		if errors.As(err, &located) {
+			*errs = append(*errs, located)
+		}
+		return err.Error()
+	}
+}
+
+func TestSchemaValidator_ValidateJSON(t *testing.T) {
+	schema := govalidator.Object(map[string]*govalidator.Schema{
+		"name": govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		"tags": govalidator.Array(govalidator.NewSchema(govalidator.IsStringValidator)),
+	})
+	validator := govalidator.NewSchemaValidator(govalidator.PathPresenter("."), govalidator.SimpleErrorPresenter())
+
+	t.Run("valid documents", func(t *testing.T) {
+		valid, errs := validator.ValidateJSON(context.Background(), []byte(`{"name": "Ann", "tags": ["a"]}`), schema, govalidator.CombinedPresenter(".", ": "))
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("value positions", func(t *testing.T) {
+		data := []byte("{\n  \"name\": 42,\n  \"tags\": [\"a\", true]\n}")
+		var located []govalidator.LocatedError
+
+		valid, _ := validator.ValidateJSON(context.Background(), data, schema, collectLocated(&located))
+
+		assert.False(t, valid)
+		assert.Equal(t, []govalidator.LocatedError{
+			{
+				Err:      govalidator.NotAStringError{},
+				Position: govalidator.SourcePosition{Offset: 12, Line: 2, Column: 11},
+				Length:   2,
+				Line:     `  "name": 42,`,
+			},
+			{
+				Err:      govalidator.NotAStringError{},
+				Position: govalidator.SourcePosition{Offset: 32, Line: 3, Column: 17},
+				Length:   4,
+				Line:     `  "tags": ["a", true]`,
+			},
+		}, located)
+	})
+
+	t.Run("multi-line values", func(t *testing.T) {
+		data := []byte("{\"name\": \"Ann\", \"tags\": [\n  \"a\"\n] }")
+		var located []govalidator.LocatedError
+
+		validator.ValidateJSON(context.Background(), data, govalidator.Object(map[string]*govalidator.Schema{
+			"tags": govalidator.NewSchema(govalidator.IsStringValidator),
+		}), collectLocated(&located))
+
+		require.Len(t, located, 1)
+		assert.Equal(t, govalidator.SourcePosition{Offset: 24, Line: 1, Column: 25}, located[0].Position)
+		assert.Equal(t, 1, located[0].Length)
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		data := []byte(`  {"tags": []}`)
+		var located []govalidator.LocatedError
+
+		_, errs := validator.ValidateJSON(context.Background(), data, schema, collectLocated(&located))
+
+		assert.Equal(t, []string{"required"}, errs)
+		require.Len(t, located, 1)
+		assert.Equal(t, govalidator.SourcePosition{Offset: 2, Line: 1, Column: 3}, located[0].Position)
+		assert.Equal(t, 12, located[0].Length)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		tests := []struct {
+			name string
+			data string
+			want govalidator.SourcePosition
+		}{
+			{name: "unexpected character", data: "{\"name\": \"Ann\",\n \"tags\" []}", want: govalidator.SourcePosition{Offset: 24, Line: 2, Column: 9}},
+			{name: "trailing data", data: `{"name": "Ann"} {}`, want: govalidator.SourcePosition{Offset: 16, Line: 1, Column: 17}},
+			{name: "truncated document", data: `{"name": "Ann"`, want: govalidator.SourcePosition{Offset: 14, Line: 1, Column: 15}},
+			{name: "empty document", data: ``, want: govalidator.SourcePosition{Offset: 0, Line: 1, Column: 1}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				var located []govalidator.LocatedError
+
+				valid, errs := validator.ValidateJSON(context.Background(), []byte(tt.data), schema, collectLocated(&located))
+
+				assert.False(t, valid)
+				assert.Equal(t, []string{"invalid JSON"}, errs)
+				require.Len(t, located, 1)
+				assert.Equal(t, govalidator.InvalidJSONError{Value: tt.data}, located[0].Err)
+				assert.Equal(t, tt.want, located[0].Position)
+			})
+		}
+	})
+
+	t.Run("error presenters", func(t *testing.T) {
+		data := []byte(`{"tags": ["a", 1]}`)
+
+		_, errs := validator.ValidateJSON(context.Background(), data, schema, func(ctx context.Context, path []string, err error) string {
+			return govalidator.DetailedErrorPresenter()(ctx, path, err) + " | " + govalidator.VerboseErrorPresenter()(ctx, path, err)
+		})
+
+		assert.Equal(t, []string{
+			"this field is required | govalidator.RequiredError: required",
+			"value must be a string | govalidator.NotAStringError: not a string",
+		}, errs)
+	})
+}
+
+func TestSchema_ValidateJSON(t *testing.T) {
+	schema := govalidator.Object(map[string]*govalidator.Schema{
+		"port": govalidator.NewSchema(govalidator.IsIntegerValidator),
+	})
+
+	valid, errs := schema.ValidateJSON(context.Background(), []byte("{\n\t\"port\": 80.5\n}"), govalidator.SourcePresenter("config.json", false))
+
+	assert.False(t, valid)
+	assert.Equal(t, []string{"config.json:2:10: $.port: not an integer"}, errs)
+}
+
+func TestSourcePresenter(t *testing.T) {
+	located := govalidator.LocatedError{
+		Err:      govalidator.NotAnIntegerError{},
+		Position: govalidator.SourcePosition{Offset: 118, Line: 7, Column: 10},
+		Length:   4,
+		Line:     "\t\"port\": 80.5,",
+	}
+	path := []string{"$", "port"}
+
+	t.Run("located errors", func(t *testing.T) {
+		out := govalidator.SourcePresenter("config.json", false)(context.Background(), path, located)
+
+		assert.Equal(t, "config.json:7:10: $.port: not an integer", out)
+	})
+
+	t.Run("snippets", func(t *testing.T) {
+		out := govalidator.SourcePresenter("config.json", true)(context.Background(), path, located)
+
+		assert.Equal(t, "config.json:7:10: $.port: not an integer\n"+
+			"7 | \t\"port\": 80.5,\n"+
+			"  | \t        ^^^^", out)
+	})
+
+	t.Run("unlocated errors", func(t *testing.T) {
+		out := govalidator.SourcePresenter("config.json", true)(context.Background(), path, govalidator.NotAnIntegerError{})
+
+		assert.Equal(t, "config.json: $.port: not an integer", out)
+	})
+}
+
+func TestLocatedError(t *testing.T) {
+	err := govalidator.LocatedError{
+		Err:      govalidator.RequiredError{},
+		Position: govalidator.SourcePosition{Offset: 5, Line: 12, Column: 3},
+		Length:   0,
+		Line:     "  {}",
+	}
+
+	assert.EqualError(t, err, "required")
+	assert.True(t, errors.Is(err, govalidator.RequiredError{}))
+	assert.Equal(t, "12:3", err.Position.String())
+	assert.Equal(t, "12 |   {}\n   |   ^", err.Snippet())
+}
+
+func TestLocatedErrorSnippet(t *testing.T) {
+	t.Run("positions without a column", func(t *testing.T) {
+		err := govalidator.LocatedError{
+			Err:      govalidator.RequiredError{},
+			Position: govalidator.SourcePosition{Line: 1},
+			Length:   2,
+			Line:     "{}",
+		}
+
+		assert.Equal(t, "1 | {}\n  | ^^", err.Snippet())
+	})
+}
@@ -0,0 +1,194 @@
+package govalidator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// StreamResult is the outcome of validating one record of a stream.
+type StreamResult struct {
+	// Index is the position of the record in the stream, starting at 0
+	Index int
+
+	// Line is the line of an NDJSON record, starting at 1, and 0 for array elements
+	Line int
+
+	// Value is the decoded record, or nil if it is not valid JSON
+	Value any
+
+	Valid  bool
+	Errors []string
+}
+
+// StreamHandler receives the result of each record as soon as it is validated.
+// Returning an error stops the stream with that error.
+type StreamHandler func(result StreamResult) error
+
+// StreamError is returned when a stream cannot be read past a record.
+type StreamError struct {
+	Index int
+	Err   error
+}
+
+// Error returns the error message.
+func (e StreamError) Error() string {
+	return fmt.Sprintf("invalid JSON stream at record %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e StreamError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	errNotAnArrayStream = errors.New("expected a top-level array")
+	errTrailingData     = errors.New("unexpected data after the top-level array")
+)
+
+// ValidateArrayStream validates each element of a top-level JSON array against
+// itemSchema as it is read, without loading the whole array. Errors are
+// presented by the combiner with the element index in the path, as "$[17].email".
+// Syntax errors stop the stream with a StreamError, as do context cancellation
+// and errors returned by the handler; handler errors are returned as they are.
+//
+// Example:
+//
+//	err := validator.ValidateArrayStream(ctx, file, itemSchema, CombinedPresenter(".", ": "),
+//	    func(result StreamResult) error {
+//	        for _, e := range result.Errors {
+//	            log.Println(e) // $[17].email: invalid email
+//	        }
+//	        return nil
+//	    })
+func (sv *SchemaValidator) ValidateArrayStream(
+	ctx context.Context,
+	reader io.Reader,
+	itemSchema *Schema,
+	combiner PresenterFunc,
+	handler StreamHandler,
+) error {
+	decoder := json.NewDecoder(reader)
+	token, err := decoder.Token()
+	if err != nil {
+		return StreamError{Index: 0, Err: err}
+	}
+	if token != json.Delim('[') {
+		return StreamError{Index: 0, Err: errNotAnArrayStream}
+	}
+
+	index := 0
+	for ; decoder.More(); index++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return StreamError{Index: index, Err: err}
+		}
+		if err := handler(sv.validateRecord(ctx, index, 0, value, itemSchema, combiner)); err != nil {
+			return err
+		}
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return StreamError{Index: index, Err: err}
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return StreamError{Index: index, Err: errTrailingData}
+	}
+	return nil
+}
+
+// ValidateNDJSONStream validates each line of newline-delimited JSON against
+// itemSchema as it is read. Blank lines are skipped and do not count as records.
+// Lines that are not valid JSON are reported as an InvalidJSONError in their
+// result, and the stream goes on. Only one line is held in memory at a time.
+//
+// Example:
+//
+//	err := validator.ValidateNDJSONStream(ctx, os.Stdin, itemSchema, CombinedPresenter(".", ": "),
+//	    func(result StreamResult) error {
+//	        if !result.Valid {
+//	            fmt.Printf("line %d: %v\n", result.Line, result.Errors)
+//	        }
+//	        return nil
+//	    })
+func (sv *SchemaValidator) ValidateNDJSONStream(
+	ctx context.Context,
+	reader io.Reader,
+	itemSchema *Schema,
+	combiner PresenterFunc,
+	handler StreamHandler,
+) error {
+	lines := bufio.NewReader(reader)
+	index := 0
+	for lineNumber := 1; ; lineNumber++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line, readErr := lines.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return StreamError{Index: index, Err: readErr}
+		}
+
+		if text := bytes.TrimSpace(line); len(text) > 0 {
+			var result StreamResult
+			var value any
+			if err := json.Unmarshal(text, &value); err != nil {
+				result = rejectedRecord(ctx, index, lineNumber, InvalidJSONError{Value: string(text)}, combiner)
+			} else {
+				result = sv.validateRecord(ctx, index, lineNumber, value, itemSchema, combiner)
+			}
+			if err := handler(result); err != nil {
+				return err
+			}
+			index++
+		}
+
+		if readErr != nil {
+			return nil
+		}
+	}
+}
+
+// validateRecord validates one record of a stream at its index.
+func (sv *SchemaValidator) validateRecord(
+	ctx context.Context,
+	index, line int,
+	value any,
+	schema *Schema,
+	combiner PresenterFunc,
+) StreamResult {
+	collector := NewFlatErrorCollector(ctx, combiner)
+
+	valCtx := &ValidationContext{
+		ctx:            ctx,
+		path:           []string{"$", fmt.Sprintf("[%d]", index)},
+		errorCollector: collector,
+		pathPresenter:  sv.pathPresenter,
+		errorPresenter: sv.errorPresenter,
+	}
+
+	sv.validateValue(valCtx, value, schema)
+
+	return StreamResult{
+		Index:  index,
+		Line:   line,
+		Value:  value,
+		Valid:  !collector.HasErrors(),
+		Errors: collector.GetFlatErrors(),
+	}
+}
+
+// rejectedRecord creates the result of a record that could not be decoded.
+func rejectedRecord(ctx context.Context, index, line int, err error, combiner PresenterFunc) StreamResult {
+	collector := NewFlatErrorCollector(ctx, combiner)
+	collector.Collect([]string{"$", fmt.Sprintf("[%d]", index)}, err)
+
+	return StreamResult{Index: index, Line: line, Errors: collector.GetFlatErrors()}
+}
@@ -0,0 +1,204 @@
+package govalidator_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectResults returns a handler appending results to the slice.
+func collectResults(results *[]govalidator.StreamResult) govalidator.StreamHandler {
+	return func(result govalidator.StreamResult) error {
+		*results = append(*results, result)
+		return nil
+	}
+}
+
+func streamItemSchema() *govalidator.Schema {
+	return govalidator.Object(map[string]*govalidator.Schema{
+		"email": govalidator.NewSchema(govalidator.IsStringValidator, govalidator.EmailValidator).Required(),
+	})
+}
+
+func TestSchemaValidator_ValidateArrayStream(t *testing.T) {
+	validator := govalidator.NewSchemaValidator(govalidator.PathPresenter("."), govalidator.SimpleErrorPresenter())
+	combiner := govalidator.CombinedPresenter(".", ": ")
+
+	t.Run("records", func(t *testing.T) {
+		var results []govalidator.StreamResult
+
+		err := validator.ValidateArrayStream(context.Background(),
+			strings.NewReader(`[{"email": "a@example.com"}, {"email": 1}, {}]`),
+			streamItemSchema(), combiner, collectResults(&results))
+
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Equal(t, govalidator.StreamResult{Index: 0, Value: map[string]any{"email": "a@example.com"}, Valid: true, Errors: []string{}}, results[0])
+		assert.Equal(t, []string{"$[1].email: not a string"}, results[1].Errors)
+		assert.False(t, results[1].Valid)
+		assert.Equal(t, 2, results[2].Index)
+		assert.Equal(t, []string{"$[2].email: required"}, results[2].Errors)
+	})
+
+	t.Run("empty arrays", func(t *testing.T) {
+		var results []govalidator.StreamResult
+
+		err := validator.ValidateArrayStream(context.Background(), strings.NewReader(" [ ]\n"), streamItemSchema(), combiner, collectResults(&results))
+
+		require.NoError(t, err)
+		assert.Empty(t, results)
+	})
+
+	t.Run("records as they arrive", func(t *testing.T) {
+		reader, writer := io.Pipe()
+		received := make(chan govalidator.StreamResult)
+		done := make(chan error)
+		go func() {
+			done <- validator.ValidateArrayStream(context.Background(), reader, streamItemSchema(), combiner,
+				func(result govalidator.StreamResult) error {
+					received <- result
+					return nil
+				})
+		}()
+
+		_, err := io.WriteString(writer, `[{"email": "a@example.com"},`)
+		require.NoError(t, err)
+		assert.Equal(t, 0, (<-received).Index)
+
+		_, err = io.WriteString(writer, `{"email": "b@example.com"}]`)
+		require.NoError(t, err)
+		assert.Equal(t, 1, (<-received).Index)
+
+		require.NoError(t, writer.Close())
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("invalid streams", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			index int
+			want  string
+		}{
+			{name: "empty input", input: "", index: 0, want: "invalid JSON stream at record 0: EOF"},
+			{name: "not an array", input: `{"email": "a@example.com"}`, index: 0, want: "invalid JSON stream at record 0: expected a top-level array"},
+			{name: "syntax error", input: `[{}, {"email" 1}]`, index: 1, want: "invalid JSON stream at record 1: invalid character '1' after object key"},
+			{name: "unterminated array", input: `[{}, {}`, index: 2, want: "invalid JSON stream at record 2: unexpected end of JSON input"},
+			{name: "trailing data", input: `[{}] []`, index: 1, want: "invalid JSON stream at record 1: unexpected data after the top-level array"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := validator.ValidateArrayStream(context.Background(), strings.NewReader(tt.input), govalidator.NewSchema(), combiner,
+					func(govalidator.StreamResult) error { return nil })
+
+				var streamErr govalidator.StreamError
+				require.ErrorAs(t, err, &streamErr)
+				assert.Equal(t, tt.index, streamErr.Index)
+				assert.EqualError(t, err, tt.want)
+			})
+		}
+	})
+
+	t.Run("handler errors", func(t *testing.T) {
+		errStop := errors.New("stop")
+		calls := 0
+
+		err := validator.ValidateArrayStream(context.Background(), strings.NewReader(`[1, 2, 3]`), govalidator.NewSchema(), combiner,
+			func(govalidator.StreamResult) error {
+				calls++
+				return errStop
+			})
+
+		assert.Equal(t, errStop, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+
+		err := validator.ValidateArrayStream(ctx, strings.NewReader(`[1, 2, 3]`), govalidator.NewSchema(), combiner,
+			func(govalidator.StreamResult) error {
+				calls++
+				cancel()
+				return nil
+			})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestSchemaValidator_ValidateNDJSONStream(t *testing.T) {
+	validator := govalidator.NewSchemaValidator(govalidator.PathPresenter("."), govalidator.SimpleErrorPresenter())
+	combiner := govalidator.CombinedPresenter(".", ": ")
+
+	t.Run("records", func(t *testing.T) {
+		var results []govalidator.StreamResult
+
+		err := validator.ValidateNDJSONStream(context.Background(),
+			strings.NewReader("{\"email\": \"a@example.com\"}\r\n\n  \n{\"email\": true}\nnot json\n{}"),
+			streamItemSchema(), combiner, collectResults(&results))
+
+		require.NoError(t, err)
+		assert.Equal(t, []govalidator.StreamResult{
+			{Index: 0, Line: 1, Value: map[string]any{"email": "a@example.com"}, Valid: true, Errors: []string{}},
+			{Index: 1, Line: 4, Value: map[string]any{"email": true}, Errors: []string{"$[1].email: not a string"}},
+			{Index: 2, Line: 5, Errors: []string{"$[2]: invalid JSON"}},
+			{Index: 3, Line: 6, Value: map[string]any{}, Errors: []string{"$[3].email: required"}},
+		}, results)
+	})
+
+	t.Run("invalid JSON errors", func(t *testing.T) {
+		var got error
+
+		err := validator.ValidateNDJSONStream(context.Background(), strings.NewReader("{\"email\":\n"), streamItemSchema(),
+			func(_ context.Context, _ []string, err error) string {
+				got = err
+				return err.Error()
+			},
+			func(govalidator.StreamResult) error { return nil })
+
+		require.NoError(t, err)
+		assert.Equal(t, govalidator.InvalidJSONError{Value: `{"email":`}, got)
+	})
+
+	t.Run("read errors", func(t *testing.T) {
+		errRead := errors.New("connection reset")
+		var results []govalidator.StreamResult
+
+		err := validator.ValidateNDJSONStream(context.Background(),
+			io.MultiReader(strings.NewReader("{}\n"), iotest.ErrReader(errRead)),
+			govalidator.NewSchema(), combiner, collectResults(&results))
+
+		assert.Equal(t, govalidator.StreamError{Index: 1, Err: errRead}, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("handler errors", func(t *testing.T) {
+		errStop := errors.New("stop")
+
+		err := validator.ValidateNDJSONStream(context.Background(), strings.NewReader("1\n2\n"), govalidator.NewSchema(), combiner,
+			func(govalidator.StreamResult) error { return errStop })
+
+		assert.Equal(t, errStop, err)
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := validator.ValidateNDJSONStream(ctx, strings.NewReader("1\n"), govalidator.NewSchema(), combiner,
+			func(govalidator.StreamResult) error { return nil })
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
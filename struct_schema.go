@@ -0,0 +1,345 @@
+package govalidator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UnknownTagRuleError is returned by SchemaFromStruct when a validate tag contains an unknown rule.
+type UnknownTagRuleError struct {
+	Type  string
+	Field string
+	Rule  string
+}
+
+// InvalidTagArgumentError is returned by SchemaFromStruct when a validate tag rule has a missing or malformed argument.
+type InvalidTagArgumentError struct {
+	Type     string
+	Field    string
+	Rule     string
+	Argument string
+}
+
+// UnsupportedTypeError is returned by SchemaFromStruct for Go types that have no JSON representation.
+type UnsupportedTypeError struct {
+	Type string
+}
+
+// Error returns the error message.
+func (e UnknownTagRuleError) Error() string {
+	return fmt.Sprintf("unknown validate rule %q on %s.%s", e.Rule, e.Type, e.Field)
+}
+
+// Error returns the error message.
+func (e InvalidTagArgumentError) Error() string {
+	return fmt.Sprintf("invalid argument %q for validate rule %q on %s.%s", e.Argument, e.Rule, e.Type, e.Field)
+}
+
+// Error returns the error message.
+func (e UnsupportedTypeError) Error() string {
+	return fmt.Sprintf("unsupported type %s", e.Type)
+}
+
+// tagPresenceRules are the validate tag rules controlling presence rather than adding a validator.
+var tagPresenceRules = map[string]func(*Schema) *Schema{
+	"required":  (*Schema).Required,
+	"omitempty": (*Schema).Optional,
+}
+
+// tagValidatorNames maps validate tag rule names to the registered names of their validators.
+// Other rule names are looked up in DefaultValidatorRegistry as they are.
+var tagValidatorNames = map[string]string{
+	"min_len":   "min_length",
+	"max_len":   "max_length",
+	"min_items": "min_size",
+	"max_items": "max_size",
+	"min":       "min_float",
+	"max":       "max_float",
+	"oneof":     "one_of",
+	"lowercase": "lower_case",
+	"uppercase": "upper_case",
+}
+
+// tagCommaEscape stands for a comma in a validate tag argument, as commas separate rules.
+const tagCommaEscape = "0x2C"
+
+// tagArguments decodes the space-separated parts of a validate tag argument like
+// JSON scalars. The one_of options of string fields stay strings; on other fields
+// they must be numbers or booleans.
+func tagArguments(name, arg string, kind reflect.Kind) ([]any, error) {
+	var args []any
+	for _, part := range strings.Fields(arg) {
+		if name == "one_of" && kind == reflect.String {
+			args = append(args, part)
+			continue
+		}
+		if part == "true" || part == "false" {
+			args = append(args, part == "true")
+			continue
+		}
+		number, err := strconv.ParseFloat(part, 64)
+		switch {
+		case err == nil:
+			args = append(args, number)
+		case name == "one_of":
+			return nil, err
+		default:
+			args = append(args, part)
+		}
+	}
+	return args, nil
+}
+
+// SchemaFromStruct builds a schema for JSON documents decoding into the given struct type.
+//
+// Field names come from the json tag. Rules come from the validate tag, for example
+// `validate:"required,min_len=3,max_len=20,oneof=a b c,email"`. Fields must be present
+// and not null unless the json tag has omitempty or the validate tag has omitempty;
+// pointer, interface, slice and map fields, which encoding/json writes as null when
+// nil, are optional unless marked required. Nested structs,
+// slices, arrays and maps with string keys are described recursively; recursive
+// types are expressed with Ref.
+//
+// Supported rules: required, omitempty, min_len, max_len, min_items, max_items,
+// min, max, oneof, lowercase and uppercase. Any other rule is looked up by name
+// in DefaultValidatorRegistry, with its space-separated argument, so built-ins
+// such as email or regex=^[a-z]+$ and registered validators can be used too.
+// Commas separate rules, so a comma in an argument is written as 0x2C, as in
+// regex=^a{10x2C3}$. Unknown rules return an UnknownTagRuleError.
+//
+// Example:
+//
+//	type SignUp struct {
+//	    Name  string `json:"name" validate:"min_len=3,max_len=20"`
+//	    Email string `json:"email" validate:"email"`
+//	    Plan  string `json:"plan,omitempty" validate:"oneof=free pro"`
+//	}
+//
+//	schema, err := SchemaFromStruct(reflect.TypeOf(SignUp{}))
+func SchemaFromStruct(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, UnsupportedTypeError{Type: t.String()}
+	}
+
+	b := &structSchemaBuilder{building: map[reflect.Type]bool{}, recursive: map[reflect.Type]bool{}}
+	return b.schemaFor(t)
+}
+
+// SchemaFor builds a schema for JSON documents decoding into T. See SchemaFromStruct.
+//
+// Example:
+//
+//	schema, err := SchemaFor[SignUp]()
+func SchemaFor[T any]() (*Schema, error) {
+	return SchemaFromStruct(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// structSchemaBuilder tracks the struct types being built to detect recursion.
+type structSchemaBuilder struct {
+	building  map[reflect.Type]bool
+	recursive map[reflect.Type]bool
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schemaFor returns a new schema describing the JSON form of t.
+//
+//nolint:cyclop // One case per reflect.Kind
+func (b *structSchemaBuilder) schemaFor(t reflect.Type) (*Schema, error) {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return b.schemaFor(t.Elem())
+	case reflect.String:
+		return NewSchema(IsStringValidator), nil
+	case reflect.Bool:
+		return NewSchema(IsBooleanValidator), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return NewSchema(IsIntegerValidator), nil
+	case reflect.Float32, reflect.Float64:
+		return NewSchema(NumberValidator), nil
+	case reflect.Interface:
+		return NewSchema(), nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Kind() == reflect.Slice {
+			// encoding/json represents []byte as a base64 string
+			return NewSchema(IsStringValidator, Base64Validator), nil
+		}
+		items, err := b.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewSchema(IsListValidator).WithItems(items), nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, UnsupportedTypeError{Type: t.String()}
+		}
+		values, err := b.schemaFor(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return NewSchema(IsMapValidator).Values(values), nil
+	case reflect.Struct:
+		return b.structSchema(t)
+	default:
+		return nil, UnsupportedTypeError{Type: t.String()}
+	}
+}
+
+// structSchema describes a struct as an object schema. Recursive references to a
+// struct under construction become a Ref, resolved by a definition on the struct's schema.
+func (b *structSchemaBuilder) structSchema(t reflect.Type) (*Schema, error) {
+	if t == timeType {
+		return NewSchema(isTimeValidator).WithTransformers(RFC3339ToTimeTransformer), nil
+	}
+	if b.building[t] {
+		b.recursive[t] = true
+		return Ref(t.String()), nil
+	}
+
+	b.building[t] = true
+	defer delete(b.building, t)
+
+	schema := NewSchema().WithFields(map[string]*Schema{})
+	if err := b.addFields(schema, t); err != nil {
+		return nil, err
+	}
+
+	if b.recursive[t] {
+		schema.Define(t.String(), schema)
+	}
+	return schema, nil
+}
+
+// addFields adds the JSON fields of struct type t to the schema, promoting
+// fields of embedded structs like encoding/json does.
+func (b *structSchemaBuilder) addFields(schema *Schema, t reflect.Type) error {
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		name, omitEmpty, skip := jsonFieldName(sf)
+		if skip {
+			continue
+		}
+
+		if sf.Anonymous && name == "" {
+			embedded := sf.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if err := b.addFields(schema, embedded); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		fieldSchema, err := b.fieldSchema(t, sf, omitEmpty)
+		if err != nil {
+			return err
+		}
+		schema.Fields[name] = fieldSchema
+	}
+	return nil
+}
+
+// fieldSchema builds the schema of a single struct field, applying presence
+// defaults and the rules of its validate tag.
+func (b *structSchemaBuilder) fieldSchema(owner reflect.Type, sf reflect.StructField, omitEmpty bool) (*Schema, error) {
+	schema, err := b.schemaFor(sf.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	// encoding/json writes nil pointers, interfaces, slices and maps as null
+	nullable := sf.Type.Kind() == reflect.Pointer || sf.Type.Kind() == reflect.Interface ||
+		sf.Type.Kind() == reflect.Slice || sf.Type.Kind() == reflect.Map
+	if omitEmpty || nullable {
+		schema.Optional()
+	} else {
+		schema.Required()
+	}
+
+	kind := sf.Type.Kind()
+	if kind == reflect.Pointer {
+		kind = sf.Type.Elem().Kind()
+	}
+
+	tag := sf.Tag.Get("validate")
+	if tag == "" {
+		return schema, nil
+	}
+
+	for _, part := range strings.Split(tag, ",") {
+		ruleName, arg, _ := strings.Cut(strings.TrimSpace(part), "=")
+		arg = strings.ReplaceAll(arg, tagCommaEscape, ",")
+		invalid := InvalidTagArgumentError{Type: owner.String(), Field: sf.Name, Rule: ruleName, Argument: arg}
+
+		if presence, ok := tagPresenceRules[ruleName]; ok {
+			presence(schema)
+			continue
+		}
+
+		name := ruleName
+		if registered, ok := tagValidatorNames[ruleName]; ok {
+			name = registered
+		}
+		args, err := tagArguments(name, arg, kind)
+		if err != nil {
+			return nil, invalid
+		}
+		validator, err := DefaultValidatorRegistry.New(name, args...)
+		var unknown UnknownValidatorError
+		switch {
+		case errors.As(err, &unknown):
+			return nil, UnknownTagRuleError{Type: owner.String(), Field: sf.Name, Rule: ruleName}
+		case err != nil:
+			return nil, invalid
+		}
+		schema.Validators = append(schema.Validators, validator)
+	}
+	return schema, nil
+}
+
+// isTimeValidator checks that a value was converted to a time.Time by RFC3339ToTimeTransformer.
+// Values the transformer passed through, such as numbers, get the transformer's error.
+func isTimeValidator(_ context.Context, value any) (twigBlock bool, errs []error) {
+	if _, ok := value.(time.Time); !ok {
+		return true, []error{CoercionError{Value: value, Target: "RFC 3339 time"}}
+	}
+	return false, nil
+}
+
+// jsonFieldName returns the JSON name of a struct field as encoding/json sees it.
+// skip is true for unexported and ignored fields.
+func jsonFieldName(sf reflect.StructField) (name string, omitEmpty bool, skip bool) {
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	if !sf.IsExported() && !sf.Anonymous {
+		return "", false, true
+	}
+
+	name, opts, _ := strings.Cut(tag, ",")
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "omitempty" || opt == "omitzero" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, false
+}
@@ -0,0 +1,308 @@
+package govalidator_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tagAddress struct {
+	City string `json:"city" validate:"min_len=2"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type tagBase struct {
+	ID string `json:"id" validate:"uuid"`
+}
+
+type tagSignUp struct {
+	tagBase
+	Name      string            `json:"name" validate:"min_len=3,max_len=20"`
+	Email     string            `json:"email" validate:"email"`
+	Plan      string            `json:"plan,omitempty" validate:"oneof=free pro"`
+	Seats     int               `json:"seats" validate:"min=1,max=100"`
+	Rating    float64           `json:"rating" validate:"oneof=1 2.5"`
+	Active    bool              `json:"active"`
+	Nickname  *string           `json:"nickname"`
+	Referrer  *string           `json:"referrer" validate:"required"`
+	Tags      []string          `json:"tags" validate:"min_items=1,max_items=3"`
+	Address   tagAddress        `json:"address"`
+	Labels    map[string]int    `json:"labels,omitempty"`
+	Extra     any               `json:"extra"`
+	Avatar    []byte            `json:"avatar,omitempty"`
+	Created   time.Time         `json:"created"`
+	Backup    *tagAddress       `json:"backup,omitempty"`
+	Ignored   string            `json:"-"`
+	NoTag     string            `validate:"omitempty"`
+	Positions [2]float32        `json:"positions,omitempty"`
+	Meta      map[string]string `json:"meta,omitempty"`
+	internal  string
+}
+
+type tagNode struct {
+	Name     string     `json:"name" validate:"min_len=1"`
+	Children []*tagNode `json:"children,omitempty"`
+}
+
+func validSignUp() map[string]any {
+	return map[string]any{
+		"id":       "550e8400-e29b-41d4-a716-446655440000",
+		"name":     "Ann",
+		"email":    "ann@example.com",
+		"seats":    5.0,
+		"rating":   2.5,
+		"active":   true,
+		"nickname": nil,
+		"referrer": "bob",
+		"tags":     []any{"a"},
+		"address":  map[string]any{"city": "Oslo"},
+		"extra":    nil,
+		"created":  "2024-01-02T00:00:00Z",
+		"NoTag":    "x",
+	}
+}
+
+func TestSchemaFromStruct(t *testing.T) {
+	schema, err := govalidator.SchemaFromStruct(reflect.TypeOf(tagSignUp{}))
+	require.NoError(t, err)
+
+	t.Run("accepts a valid document", func(t *testing.T) {
+		valid, errs := schema.Validate(context.Background(), validSignUp())
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("applies tag rules", func(t *testing.T) {
+		data := validSignUp()
+		data["id"] = "nope"
+		data["name"] = "Al"
+		data["email"] = "ann"
+		data["plan"] = "gold"
+		data["seats"] = 0.0
+		data["tags"] = []any{}
+		data["address"] = map[string]any{"city": "X"}
+
+		_, errs := schema.Validate(context.Background(), data)
+
+		assert.ElementsMatch(t, []string{"$.id", "$.name", "$.email", "$.plan", "$.seats", "$.tags", "$.address.city"}, keys(errs))
+	})
+
+	t.Run("accepts the JSON form of the zero value", func(t *testing.T) {
+		type zero struct {
+			Name   string            `json:"name"`
+			Tags   []string          `json:"tags" validate:"min_items=1"`
+			Labels map[string]string `json:"labels"`
+			Extra  any               `json:"extra"`
+			Owner  *tagAddress       `json:"owner"`
+		}
+		zeroSchema, err := govalidator.SchemaFor[zero]()
+		require.NoError(t, err)
+		data, err := json.Marshal(zero{})
+		require.NoError(t, err)
+		var decoded any
+		require.NoError(t, json.Unmarshal(data, &decoded))
+
+		valid, errs := zeroSchema.Validate(context.Background(), decoded)
+		assert.True(t, valid, errs)
+
+		valid, errs = zeroSchema.Validate(context.Background(), zero{})
+		assert.True(t, valid, errs)
+	})
+
+	t.Run("slices and maps are required when marked", func(t *testing.T) {
+		type tagged struct {
+			Tags []string `json:"tags" validate:"required"`
+		}
+		taggedSchema, err := govalidator.SchemaFor[tagged]()
+		require.NoError(t, err)
+
+		_, errs := taggedSchema.Validate(context.Background(), map[string]any{"tags": nil})
+
+		assert.Equal(t, map[string][]string{"$.tags": {"required"}}, errs)
+	})
+
+	t.Run("non-pointer fields are required unless omitempty", func(t *testing.T) {
+		data := validSignUp()
+		delete(data, "name")
+		delete(data, "plan")
+		delete(data, "nickname")
+		data["referrer"] = nil
+
+		_, errs := schema.Validate(context.Background(), data)
+
+		assert.Equal(t, map[string][]string{
+			"$.name":     {"required"},
+			"$.referrer": {"required"},
+		}, errs)
+	})
+
+	t.Run("checks types of nested values", func(t *testing.T) {
+		data := validSignUp()
+		data["active"] = "yes"
+		data["tags"] = []any{1}
+		data["labels"] = map[string]any{"a": "b"}
+		data["backup"] = map[string]any{"city": 1}
+		data["created"] = 1.0
+
+		_, errs := schema.Validate(context.Background(), data)
+
+		assert.ElementsMatch(t, []string{"$.active", "$.tags[0]", "$.labels.a", "$.backup.city", "$.created"}, keys(errs))
+		assert.Equal(t, []string{`cannot convert "1" to RFC 3339 time`}, errs["$.created"])
+	})
+
+	t.Run("normalizes times", func(t *testing.T) {
+		normalized, valid, _ := schema.Normalize(context.Background(), validSignUp())
+
+		require.True(t, valid)
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), normalized.(map[string]any)["created"])
+	})
+
+	t.Run("accepts pointer types", func(t *testing.T) {
+		_, err := govalidator.SchemaFromStruct(reflect.TypeOf(&tagSignUp{}))
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestSchemaFor(t *testing.T) {
+	t.Run("recursive types", func(t *testing.T) {
+		schema, err := govalidator.SchemaFor[tagNode]()
+		require.NoError(t, err)
+
+		valid, _ := schema.Validate(context.Background(), map[string]any{
+			"name":     "root",
+			"children": []any{map[string]any{"name": "leaf"}},
+		})
+		assert.True(t, valid)
+
+		_, errs := schema.Validate(context.Background(), map[string]any{
+			"name":     "root",
+			"children": []any{map[string]any{"name": ""}},
+		})
+		assert.Equal(t, map[string][]string{"$.children[0].name": {"expected at least 1 characters"}}, errs)
+	})
+
+	t.Run("registered validators", func(t *testing.T) {
+		digits := regexp.MustCompile(`^[0-9]+$`)
+		require.NoError(t, govalidator.DefaultValidatorRegistry.Register("test_digits", func([]any) (govalidator.ContextValidator, error) {
+			return govalidator.RegexpValidator(*digits), nil
+		}))
+		t.Cleanup(func() { govalidator.DefaultValidatorRegistry.Unregister("test_digits") })
+
+		type account struct {
+			Handle string `json:"handle" validate:"regex=^[a-z]+$,max_length=8"`
+			Digits string `json:"digits" validate:"test_digits"`
+		}
+
+		schema, err := govalidator.SchemaFor[account]()
+		require.NoError(t, err)
+
+		valid, _ := schema.Validate(context.Background(), map[string]any{"handle": "ann", "digits": "42"})
+		assert.True(t, valid)
+		_, errs := schema.Validate(context.Background(), map[string]any{"handle": "Ann_the_First", "digits": "4two"})
+		assert.ElementsMatch(t, []string{"$.handle", "$.digits"}, keys(errs))
+		assert.Len(t, errs["$.handle"], 2)
+	})
+
+	t.Run("escaped commas", func(t *testing.T) {
+		type account struct {
+			Handle string `json:"handle" validate:"regex=^a{10x2C3}$,max_length=8"`
+			Size   string `json:"size" validate:"oneof=S0x2CM L"`
+		}
+
+		schema, err := govalidator.SchemaFor[account]()
+		require.NoError(t, err)
+
+		valid, _ := schema.Validate(context.Background(), map[string]any{"handle": "aaa", "size": "S,M"})
+		assert.True(t, valid)
+		_, errs := schema.Validate(context.Background(), map[string]any{"handle": "aaaa", "size": "S"})
+		assert.ElementsMatch(t, []string{"$.handle", "$.size"}, keys(errs))
+	})
+
+	t.Run("unknown rule", func(t *testing.T) {
+		type bad struct {
+			Name string `json:"name" validate:"min_len=1,shiny"`
+		}
+
+		_, err := govalidator.SchemaFor[bad]()
+
+		assert.Equal(t, govalidator.UnknownTagRuleError{Type: "govalidator_test.bad", Field: "Name", Rule: "shiny"}, err)
+		assert.EqualError(t, err, `unknown validate rule "shiny" on govalidator_test.bad.Name`)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		type badLen struct {
+			Name string `validate:"min_len=abc"`
+		}
+		type badFlag struct {
+			Email string `validate:"email=yes"`
+		}
+		type badOneOf struct {
+			Count int `validate:"oneof=a"`
+		}
+		type emptyOneOf struct {
+			Name string `validate:"oneof="`
+		}
+		type badMin struct {
+			Count int `validate:"min=x"`
+		}
+
+		_, err := govalidator.SchemaFor[badLen]()
+		assert.EqualError(t, err, `invalid argument "abc" for validate rule "min_len" on govalidator_test.badLen.Name`)
+		_, err = govalidator.SchemaFor[badFlag]()
+		assert.IsType(t, govalidator.InvalidTagArgumentError{}, err)
+		_, err = govalidator.SchemaFor[badOneOf]()
+		assert.IsType(t, govalidator.InvalidTagArgumentError{}, err)
+		_, err = govalidator.SchemaFor[emptyOneOf]()
+		assert.IsType(t, govalidator.InvalidTagArgumentError{}, err)
+		_, err = govalidator.SchemaFor[badMin]()
+		assert.IsType(t, govalidator.InvalidTagArgumentError{}, err)
+	})
+
+	t.Run("unsupported types", func(t *testing.T) {
+		type withChan struct {
+			C chan int
+		}
+		type withIntKeys struct {
+			M map[int]string
+		}
+		type withBadItems struct {
+			L []chan int
+		}
+		type withBadValues struct {
+			M map[string]func()
+		}
+		type withBadNested struct {
+			N withChan
+		}
+
+		_, err := govalidator.SchemaFor[int]()
+		assert.EqualError(t, err, "unsupported type int")
+		_, err = govalidator.SchemaFor[withChan]()
+		assert.EqualError(t, err, "unsupported type chan int")
+		_, err = govalidator.SchemaFor[withIntKeys]()
+		assert.EqualError(t, err, "unsupported type map[int]string")
+		_, err = govalidator.SchemaFor[withBadItems]()
+		assert.Error(t, err)
+		_, err = govalidator.SchemaFor[withBadValues]()
+		assert.Error(t, err)
+		_, err = govalidator.SchemaFor[withBadNested]()
+		assert.Error(t, err)
+	})
+}
+
+func keys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
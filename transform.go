@@ -0,0 +1,134 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Transformer converts a value before it is validated.
+// The returned value is passed to the validators and appears in the normalized output.
+// Transformers should return non-string values unchanged so that already typed data passes through.
+type Transformer func(ctx context.Context, value any) (any, error)
+
+// CoercionError is returned when a transformer cannot convert a value.
+type CoercionError struct {
+	Value  any
+	Target string
+}
+
+// Error returns the error message.
+func (e CoercionError) Error() string {
+	return fmt.Sprintf("cannot convert %q to %s", fmt.Sprint(e.Value), e.Target)
+}
+
+// WithTransformers sets the transformers applied to a non-null value before its validators run.
+// Transformers run in order, each receiving the output of the previous one.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	NewSchema(IsIntegerValidator, MinFloatValidator(1)).WithTransformers(TrimSpaceTransformer, StringToIntTransformer)
+func (s *Schema) WithTransformers(transformers ...Transformer) *Schema {
+	s.transformers = transformers
+	return s
+}
+
+// WithTransformers sets the transformers applied to the field before its validators run.
+// Returns the field for method chaining.
+//
+// Example:
+//
+//	NewField("age").WithTransformers(StringToIntTransformer).WithValidators(IsIntegerValidator)
+func (f *Field) WithTransformers(transformers ...Transformer) *Field {
+	f.schema.WithTransformers(transformers...)
+	return f
+}
+
+// StringToIntTransformer converts a decimal string such as "42" to an int.
+func StringToIntTransformer(_ context.Context, value any) (any, error) {
+	str, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	i, err := strconv.Atoi(str)
+	if err != nil {
+		return value, CoercionError{Value: value, Target: "integer"}
+	}
+	return i, nil
+}
+
+// StringToFloatTransformer converts a numeric string such as "4.5" to a float64.
+func StringToFloatTransformer(_ context.Context, value any) (any, error) {
+	str, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	f, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return value, CoercionError{Value: value, Target: "number"}
+	}
+	return f, nil
+}
+
+// StringToBoolTransformer converts strings accepted by strconv.ParseBool, such as "true" or "0", to a bool.
+func StringToBoolTransformer(_ context.Context, value any) (any, error) {
+	str, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	b, err := strconv.ParseBool(str)
+	if err != nil {
+		return value, CoercionError{Value: value, Target: "boolean"}
+	}
+	return b, nil
+}
+
+// RFC3339ToTimeTransformer converts an RFC 3339 string such as "2024-01-02T15:04:05Z" to a time.Time.
+func RFC3339ToTimeTransformer(_ context.Context, value any) (any, error) {
+	str, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return value, CoercionError{Value: value, Target: "RFC 3339 time"}
+	}
+	return t, nil
+}
+
+// TrimSpaceTransformer removes leading and trailing white space from strings.
+func TrimSpaceTransformer(_ context.Context, value any) (any, error) {
+	if str, ok := value.(string); ok {
+		return strings.TrimSpace(str), nil
+	}
+	return value, nil
+}
+
+// LowerCaseTransformer converts strings to lower case.
+func LowerCaseTransformer(_ context.Context, value any) (any, error) {
+	if str, ok := value.(string); ok {
+		return strings.ToLower(str), nil
+	}
+	return value, nil
+}
+
+// runTransformers applies the schema's transformers to the value.
+// Returns false if a transformer failed; the error is collected at the current path.
+func (sv *SchemaValidator) runTransformers(valCtx *ValidationContext, value any, schema *Schema) (any, bool) {
+	for _, transformer := range schema.transformers {
+		transformed, err := transformer(valCtx.ctx, value)
+		if err != nil {
+			valCtx.errorCollector.Collect(valCtx.path, err)
+			return value, false
+		}
+		value = transformed
+	}
+	return value, true
+}
@@ -0,0 +1,146 @@
+package govalidator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformers(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		transformer govalidator.Transformer
+		value       any
+		want        any
+		wantErr     error
+	}{
+		{name: "string to int", transformer: govalidator.StringToIntTransformer, value: "42", want: 42},
+		{name: "string to int rejects decimals", transformer: govalidator.StringToIntTransformer, value: "4.2", want: "4.2",
+			wantErr: govalidator.CoercionError{Value: "4.2", Target: "integer"}},
+		{name: "string to int passes numbers through", transformer: govalidator.StringToIntTransformer, value: 42.0, want: 42.0},
+		{name: "string to float", transformer: govalidator.StringToFloatTransformer, value: "4.5", want: 4.5},
+		{name: "string to float rejects text", transformer: govalidator.StringToFloatTransformer, value: "abc", want: "abc",
+			wantErr: govalidator.CoercionError{Value: "abc", Target: "number"}},
+		{name: "string to float passes numbers through", transformer: govalidator.StringToFloatTransformer, value: 1, want: 1},
+		{name: "string to bool", transformer: govalidator.StringToBoolTransformer, value: "true", want: true},
+		{name: "string to bool accepts digits", transformer: govalidator.StringToBoolTransformer, value: "0", want: false},
+		{name: "string to bool rejects text", transformer: govalidator.StringToBoolTransformer, value: "yes", want: "yes",
+			wantErr: govalidator.CoercionError{Value: "yes", Target: "boolean"}},
+		{name: "string to bool passes bools through", transformer: govalidator.StringToBoolTransformer, value: true, want: true},
+		{name: "rfc3339 to time", transformer: govalidator.RFC3339ToTimeTransformer, value: "2024-01-02T15:04:05Z",
+			want: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{name: "rfc3339 rejects dates", transformer: govalidator.RFC3339ToTimeTransformer, value: "2024-01-02", want: "2024-01-02",
+			wantErr: govalidator.CoercionError{Value: "2024-01-02", Target: "RFC 3339 time"}},
+		{name: "rfc3339 passes other values through", transformer: govalidator.RFC3339ToTimeTransformer, value: 1, want: 1},
+		{name: "trim space", transformer: govalidator.TrimSpaceTransformer, value: "  a b ", want: "a b"},
+		{name: "trim space passes other values through", transformer: govalidator.TrimSpaceTransformer, value: 1, want: 1},
+		{name: "lower case", transformer: govalidator.LowerCaseTransformer, value: "ABC", want: "abc"},
+		{name: "lower case passes other values through", transformer: govalidator.LowerCaseTransformer, value: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.transformer(ctx, tt.value)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestSchema_WithTransformers(t *testing.T) {
+	t.Run("validators receive the converted value", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithFields(
+			govalidator.NewField("age").Required().
+				WithTransformers(govalidator.TrimSpaceTransformer, govalidator.StringToIntTransformer).
+				WithValidators(govalidator.IsIntegerValidator, govalidator.MinFloatValidator(18)),
+			govalidator.NewField("active").
+				WithTransformers(govalidator.StringToBoolTransformer).
+				WithValidators(govalidator.IsBooleanValidator),
+		)
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"age": " 42 ", "active": "true"})
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+
+		_, errs = schema.Validate(context.Background(), map[string]any{"age": "12"})
+		assert.Equal(t, map[string][]string{"$.age": {"value is less than min"}}, errs)
+	})
+
+	t.Run("conversion failure stops validation", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"price": govalidator.NewSchema(govalidator.NumberValidator).WithTransformers(govalidator.StringToFloatTransformer),
+		})
+
+		valid, errs := schema.Validate(context.Background(), map[string]any{"price": "cheap"})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.price": {`cannot convert "cheap" to number`}}, errs)
+	})
+
+	t.Run("converted values appear in the normalized output", func(t *testing.T) {
+		schema := govalidator.Object(map[string]*govalidator.Schema{
+			"email": govalidator.NewSchema(govalidator.IsStringValidator).
+				WithTransformers(govalidator.TrimSpaceTransformer, govalidator.LowerCaseTransformer),
+			"since": govalidator.NewSchema().WithTransformers(govalidator.RFC3339ToTimeTransformer),
+			"tags":  govalidator.Array(govalidator.NewSchema(govalidator.IsIntegerValidator).WithTransformers(govalidator.StringToIntTransformer)),
+		})
+		input := map[string]any{"email": " John@Example.COM ", "since": "2024-01-02T00:00:00Z", "tags": []any{"1", 2}}
+
+		normalized, valid, _ := schema.Normalize(context.Background(), input)
+
+		require.True(t, valid)
+		assert.Equal(t, map[string]any{
+			"email": "john@example.com",
+			"since": time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			"tags":  []any{1, 2},
+		}, normalized)
+		assert.Equal(t, " John@Example.COM ", input["email"])
+	})
+
+	t.Run("null values are not transformed", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithTransformers(govalidator.StringToIntTransformer)
+
+		valid, _ := schema.Validate(context.Background(), nil)
+
+		assert.True(t, valid)
+	})
+
+	t.Run("custom transformer errors are reported as returned", func(t *testing.T) {
+		schema := govalidator.NewSchema().WithTransformers(func(_ context.Context, value any) (any, error) {
+			return nil, errors.New("boom")
+		})
+
+		_, errs := schema.Validate(context.Background(), "x")
+
+		assert.Equal(t, map[string][]string{"$": {"boom"}}, errs)
+	})
+
+	t.Run("legacy definition runs transformers", func(t *testing.T) {
+		schema := govalidator.NewSchema(govalidator.IsIntegerValidator).WithTransformers(govalidator.StringToIntTransformer)
+
+		valid, _ := govalidator.NewBasicValidator(
+			govalidator.PathPresenter("."),
+			govalidator.SimpleErrorPresenter(),
+		).Validate(context.Background(), "42", schema.ToDefinition())
+
+		assert.True(t, valid)
+	})
+}
+
+func TestCoercionError_Presenters(t *testing.T) {
+	ctx := context.Background()
+	err := govalidator.CoercionError{Value: "abc", Target: "integer"}
+
+	assert.Equal(t, `value must be convertible to integer (got "abc")`, govalidator.DetailedErrorPresenter()(ctx, nil, err))
+	assert.Equal(t, "CoercionError: cannot convert abc (string) to integer", govalidator.VerboseErrorPresenter()(ctx, nil, err))
+	assert.JSONEq(t,
+		`{"path":"$.age","message":"cannot convert \"abc\" to integer","type":"CoercionError","value":"abc","target":"integer"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "age"}, err))
+}
@@ -0,0 +1,89 @@
+package govalidator
+
+import "fmt"
+
+// TupleLengthError is returned when a tuple has fewer items than its required
+// positions or more items than allowed. MaxItems is -1 when additional items are allowed.
+type TupleLengthError struct {
+	MinItems    int
+	MaxItems    int
+	ActualItems int
+}
+
+// Error returns the error message.
+func (e TupleLengthError) Error() string {
+	switch {
+	case e.MinItems == e.MaxItems:
+		return fmt.Sprintf("expected %d items, got %d", e.MinItems, e.ActualItems)
+	case e.ActualItems < e.MinItems:
+		return fmt.Sprintf("expected at least %d items, got %d", e.MinItems, e.ActualItems)
+	default:
+		return fmt.Sprintf("expected at most %d items, got %d", e.MaxItems, e.ActualItems)
+	}
+}
+
+// Tuple creates a schema for positional arrays where each item has its own schema.
+// Trailing positions whose schema is not required may be omitted.
+// Items after the positional ones are validated against the Items schema
+// (see WithItems), accepted when no Items schema is set, or rejected with
+// NoAdditionalItems.
+//
+// Example:
+//
+//	// [timestamp, value]
+//	sample := Tuple(
+//	    NewSchema(IsIntegerValidator).Required(),
+//	    NewSchema(NumberValidator).Required(),
+//	).NoAdditionalItems()
+func Tuple(items ...*Schema) *Schema {
+	return &Schema{
+		Validators:  []ContextValidator{IsListValidator},
+		prefixItems: items,
+	}
+}
+
+// NoAdditionalItems rejects tuples with more items than positional schemas.
+// Returns the schema for method chaining.
+//
+// Example:
+//
+//	point := Tuple(NewSchema(NumberValidator).Required(), NewSchema(NumberValidator).Required()).NoAdditionalItems()
+func (s *Schema) NoAdditionalItems() *Schema {
+	s.closedTuple = true
+	return s
+}
+
+// isArray reports whether this schema describes an array.
+func (s *Schema) isArray() bool {
+	return s.Items != nil || s.prefixItems != nil
+}
+
+// tupleBounds returns the minimum and maximum number of items of a tuple schema.
+// The maximum is -1 when additional items are allowed.
+func (s *Schema) tupleBounds() (minItems, maxItems int) {
+	for i, item := range s.prefixItems {
+		if item != nil && item.required {
+			minItems = i + 1
+		}
+	}
+
+	maxItems = -1
+	if s.closedTuple {
+		maxItems = len(s.prefixItems)
+	}
+	return minItems, maxItems
+}
+
+// validateTupleLength checks the number of items against the tuple's positional schemas.
+func (sv *SchemaValidator) validateTupleLength(valCtx *ValidationContext, list []any, schema *Schema) {
+	minItems, maxItems := schema.tupleBounds()
+	if len(list) >= minItems && (maxItems < 0 || len(list) <= maxItems) {
+		return
+	}
+
+	valCtx.errorCollector.Collect(valCtx.path, TupleLengthError{
+		MinItems:    minItems,
+		MaxItems:    maxItems,
+		ActualItems: len(list),
+	})
+}
@@ -0,0 +1,111 @@
+package govalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTuple(t *testing.T) {
+	sample := govalidator.Tuple(
+		govalidator.NewSchema(govalidator.IsIntegerValidator).Required(),
+		govalidator.NewSchema(govalidator.NumberValidator).Required(),
+		govalidator.NewSchema(govalidator.IsStringValidator),
+	)
+
+	t.Run("validates positional items", func(t *testing.T) {
+		valid, errs := sample.Validate(context.Background(), []any{1700000000, 21.5, "celsius"})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("reports errors at item paths", func(t *testing.T) {
+		valid, errs := sample.Validate(context.Background(), []any{"now", 21.5, 3})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{
+			"$[0]": {"not an integer"},
+			"$[2]": {"not a string"},
+		}, errs)
+	})
+
+	t.Run("optional trailing items may be omitted", func(t *testing.T) {
+		valid, errs := sample.Validate(context.Background(), []any{1700000000, 21.5})
+
+		assert.True(t, valid)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("reports too few items", func(t *testing.T) {
+		valid, errs := sample.Validate(context.Background(), []any{1700000000})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"expected at least 2 items, got 1"}}, errs)
+	})
+
+	t.Run("accepts additional items by default", func(t *testing.T) {
+		valid, _ := sample.Validate(context.Background(), []any{1, 2, "c", true, nil})
+
+		assert.True(t, valid)
+	})
+
+	t.Run("validates additional items against rest schema", func(t *testing.T) {
+		row := govalidator.Tuple(
+			govalidator.NewSchema(govalidator.IsStringValidator).Required(),
+		).WithItems(govalidator.NewSchema(govalidator.NumberValidator).Required())
+
+		valid, errs := row.Validate(context.Background(), []any{"total", 1, "two", 3.5})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$[2]": {"not a number"}}, errs)
+	})
+
+	t.Run("rejects additional items when closed", func(t *testing.T) {
+		point := govalidator.Tuple(
+			govalidator.NewSchema(govalidator.NumberValidator).Required(),
+			govalidator.NewSchema(govalidator.NumberValidator).Required(),
+		).NoAdditionalItems()
+
+		valid, errs := point.Validate(context.Background(), []any{1, 2, 3})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"expected 2 items, got 3"}}, errs)
+	})
+
+	t.Run("reports too many items with optional positions", func(t *testing.T) {
+		schema := govalidator.Tuple(
+			govalidator.NewSchema(govalidator.NumberValidator).Required(),
+			govalidator.NewSchema(govalidator.NumberValidator),
+		).NoAdditionalItems()
+
+		valid, errs := schema.Validate(context.Background(), []any{1, 2, 3})
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"expected at most 2 items, got 3"}}, errs)
+	})
+
+	t.Run("rejects non-list values", func(t *testing.T) {
+		valid, errs := sample.Validate(context.Background(), "1,2")
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"not a list"}}, errs)
+	})
+}
+
+func TestTupleLengthError_Presenters(t *testing.T) {
+	ctx := context.Background()
+	presenter := govalidator.DetailedErrorPresenter()
+
+	assert.Equal(t, "list must contain exactly 2 item(s) (got 3)",
+		presenter(ctx, nil, govalidator.TupleLengthError{MinItems: 2, MaxItems: 2, ActualItems: 3}))
+	assert.Equal(t, "list must contain at least 2 item(s) (got 1)",
+		presenter(ctx, nil, govalidator.TupleLengthError{MinItems: 2, MaxItems: -1, ActualItems: 1}))
+	assert.Equal(t, "list must contain at most 3 item(s) (got 4)",
+		presenter(ctx, nil, govalidator.TupleLengthError{MinItems: 1, MaxItems: 3, ActualItems: 4}))
+	assert.JSONEq(t,
+		`{"path":"$","message":"expected 2 items, got 3","type":"TupleLengthError","minItems":2,"maxItems":2,"actualItems":3}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$"}, govalidator.TupleLengthError{MinItems: 2, MaxItems: 2, ActualItems: 3}))
+}
@@ -0,0 +1,121 @@
+package govalidator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DecodeTypeError is returned when a valid value cannot be decoded into the target Go type.
+type DecodeTypeError struct {
+	Value string
+	Type  string
+}
+
+// Error returns the error message.
+func (e DecodeTypeError) Error() string {
+	return "cannot decode " + e.Value + " into " + e.Type
+}
+
+var errNilTarget = errors.New("nil decode target")
+
+// ValidateInto decodes JSON data, validates it against the schema and, only if
+// validation passes, decodes the normalized document into out. Defaults and
+// transformers are applied before decoding. Errors are keyed by the same paths
+// as Schema.Validate, including type mismatches reported by encoding/json.
+// Numbers are decoded without going through float64, so integers beyond 2^53
+// such as int64 IDs keep their exact value. A nil out fails without validating.
+//
+// Example:
+//
+//	var user User
+//	valid, errs := ValidateInto(ctx, body, userSchema, &user)
+//	if !valid {
+//	    // errs: map[$.age:[cannot decode string into int]]
+//	}
+func ValidateInto[T any](ctx context.Context, data []byte, schema *Schema, out *T) (bool, map[string][]string) {
+	sv := NewSchemaValidator(PathPresenter("."), SimpleErrorPresenter())
+	collector := NewMapErrorCollector(ctx, sv.pathPresenter, sv.errorPresenter)
+	if out == nil {
+		collector.Collect([]string{"$"}, errNilTarget)
+		return false, collector.GetErrors()
+	}
+
+	value, err := decodeExactJSON(data)
+	if err != nil {
+		collector.Collect([]string{"$"}, InvalidJSONError{Value: string(data)})
+		return false, collector.GetErrors()
+	}
+
+	normalized, valid, errs := sv.Normalize(ctx, value, schema)
+	if !valid {
+		return false, errs
+	}
+
+	encoded, err := json.Marshal(normalized)
+	if err != nil {
+		collector.Collect([]string{"$"}, err)
+		return false, collector.GetErrors()
+	}
+
+	var decoded T
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		collectDecodeError(collector, normalized, err)
+		return false, collector.GetErrors()
+	}
+
+	*out = decoded
+	return true, collector.GetErrors()
+}
+
+// decodeExactJSON decodes a single JSON document like json.Unmarshal, keeping
+// numbers as json.Number so that adaptValue can convert them without rounding.
+func decodeExactJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after the top-level value")
+	}
+	return value, nil
+}
+
+// collectDecodeError maps an encoding/json decode error onto the path of the
+// offending field. encoding/json joins field names and list indexes with dots,
+// so the decoded document tells which segments are indexes.
+func collectDecodeError(collector ErrorCollector, document any, err error) {
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		collector.Collect([]string{"$"}, err)
+		return
+	}
+
+	path := []string{"$"}
+	if typeErr.Field != "" {
+		for _, segment := range strings.Split(typeErr.Field, ".") {
+			list, isList := document.([]any)
+			index, indexErr := strconv.Atoi(segment)
+			if isList && indexErr == nil && index >= 0 && index < len(list) {
+				path = append(path, fmt.Sprintf("[%d]", index))
+				document = list[index]
+				continue
+			}
+			path = append(path, segment)
+			object, _ := document.(map[string]any)
+			document = object[segment]
+		}
+	}
+	collector.Collect(path, DecodeTypeError{
+		Value: typeErr.Value,
+		Type:  typeErr.Type.String(),
+	})
+}
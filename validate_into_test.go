@@ -0,0 +1,179 @@
+package govalidator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type intoAddress struct {
+	City string `json:"city"`
+	Zip  int    `json:"zip"`
+}
+
+type intoUser struct {
+	Name    string      `json:"name"`
+	Age     int         `json:"age"`
+	Role    string      `json:"role"`
+	Since   time.Time   `json:"since"`
+	Address intoAddress `json:"address"`
+}
+
+type intoOrder struct {
+	ID    int64                  `json:"id"`
+	Items []intoAddress          `json:"items"`
+	Notes map[string]intoAddress `json:"notes"`
+}
+
+type intoStrict struct{}
+
+func (intoStrict) UnmarshalJSON([]byte) error {
+	return errors.New("strict decoding failed")
+}
+
+func TestValidateInto(t *testing.T) {
+	schema := govalidator.NewSchema().WithFields(
+		govalidator.NewField("name").Required().WithValidators(govalidator.IsStringValidator, govalidator.MinLengthValidator(2)),
+		govalidator.NewField("age").WithTransformers(govalidator.StringToIntTransformer),
+		govalidator.NewField("role").Default("member"),
+		govalidator.NewField("since").WithTransformers(govalidator.RFC3339ToTimeTransformer),
+		govalidator.NewField("address").WithSchema(govalidator.NewSchema().WithFields(
+			govalidator.NewField("city").WithValidators(govalidator.IsStringValidator),
+			govalidator.NewField("zip"),
+		)),
+	)
+
+	t.Run("decodes normalized document on success", func(t *testing.T) {
+		var user intoUser
+		data := []byte(`{"name": "Ann", "age": "42", "since": "2024-01-02T00:00:00Z", "address": {"city": "Oslo", "zip": 150}}`)
+
+		valid, errs := govalidator.ValidateInto(context.Background(), data, schema, &user)
+
+		require.True(t, valid)
+		assert.Empty(t, errs)
+		assert.Equal(t, intoUser{
+			Name:    "Ann",
+			Age:     42,
+			Role:    "member",
+			Since:   time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			Address: intoAddress{City: "Oslo", Zip: 150},
+		}, user)
+	})
+
+	t.Run("leaves out untouched when validation fails", func(t *testing.T) {
+		user := intoUser{Name: "unchanged"}
+
+		valid, errs := govalidator.ValidateInto(context.Background(), []byte(`{"name": "A"}`), schema, &user)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.name": {"expected at least 2 characters"}}, errs)
+		assert.Equal(t, intoUser{Name: "unchanged"}, user)
+	})
+
+	t.Run("maps type mismatches onto paths", func(t *testing.T) {
+		user := intoUser{Name: "unchanged"}
+
+		valid, errs := govalidator.ValidateInto(context.Background(), []byte(`{"name": "Ann", "address": {"zip": "0150"}}`), schema, &user)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.address.zip": {"cannot decode string into int"}}, errs)
+		assert.Equal(t, intoUser{Name: "unchanged"}, user)
+	})
+
+	t.Run("keeps large integers exact", func(t *testing.T) {
+		var order intoOrder
+
+		valid, errs := govalidator.ValidateInto(context.Background(), []byte(`{"id": 9007199254740993}`),
+			govalidator.NewSchema().WithFields(govalidator.NewField("id").WithValidators(govalidator.IsIntegerValidator)), &order)
+
+		require.True(t, valid)
+		assert.Empty(t, errs)
+		assert.Equal(t, int64(9007199254740993), order.ID)
+	})
+
+	t.Run("maps type mismatches in lists onto indexes", func(t *testing.T) {
+		var order intoOrder
+
+		valid, errs := govalidator.ValidateInto(context.Background(),
+			[]byte(`{"items": [{"city": "Oslo"}, {"city": "Bergen", "zip": "5003"}]}`), govalidator.NewSchema(), &order)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.items[1].zip": {"cannot decode string into int"}}, errs)
+	})
+
+	t.Run("keeps numeric map keys", func(t *testing.T) {
+		var order intoOrder
+
+		valid, errs := govalidator.ValidateInto(context.Background(), []byte(`{"notes": {"0": {"zip": "x"}}}`), govalidator.NewSchema(), &order)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$.notes.0.zip": {"cannot decode string into int"}}, errs)
+	})
+
+	t.Run("reports type mismatch of the root", func(t *testing.T) {
+		var names []string
+
+		valid, errs := govalidator.ValidateInto(context.Background(), []byte(`{"a": 1}`), govalidator.NewSchema(), &names)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"cannot decode object into []string"}}, errs)
+	})
+
+	t.Run("reports other decode errors at the root", func(t *testing.T) {
+		var out intoStrict
+
+		valid, errs := govalidator.ValidateInto(context.Background(), []byte(`{}`), govalidator.NewSchema(), &out)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"strict decoding failed"}}, errs)
+	})
+
+	t.Run("reports a nil target", func(t *testing.T) {
+		valid, errs := govalidator.ValidateInto[intoUser](context.Background(), []byte(`{"name": "Ann"}`), schema, nil)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"nil decode target"}}, errs)
+	})
+
+	t.Run("reports invalid JSON", func(t *testing.T) {
+		var user intoUser
+
+		valid, errs := govalidator.ValidateInto(context.Background(), []byte(`{"name":`), schema, &user)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"invalid JSON"}}, errs)
+
+		valid, errs = govalidator.ValidateInto(context.Background(), []byte(`{"name": "Ann"} {}`), schema, &user)
+
+		assert.False(t, valid)
+		assert.Equal(t, map[string][]string{"$": {"invalid JSON"}}, errs)
+	})
+
+	t.Run("reports values that cannot be encoded", func(t *testing.T) {
+		var out map[string]any
+		broken := govalidator.NewSchema().WithTransformers(func(context.Context, any) (any, error) {
+			return func() {}, nil
+		})
+
+		valid, errs := govalidator.ValidateInto(context.Background(), []byte(`1`), broken, &out)
+
+		assert.False(t, valid)
+		assert.Contains(t, errs, "$")
+	})
+}
+
+func TestDecodeTypeError_Presenters(t *testing.T) {
+	ctx := context.Background()
+	err := govalidator.DecodeTypeError{Value: "string", Type: "int"}
+
+	assert.Equal(t, "value of type string cannot be stored as int", govalidator.DetailedErrorPresenter()(ctx, nil, err))
+	assert.Equal(t, "DecodeTypeError: JSON string does not fit Go type int", govalidator.VerboseErrorPresenter()(ctx, nil, err))
+	assert.JSONEq(t,
+		`{"path":"$.age","message":"cannot decode string into int","type":"DecodeTypeError","value":"string","goType":"int"}`,
+		govalidator.JSONDetailedPresenter(".")(ctx, []string{"$", "age"}, err))
+}
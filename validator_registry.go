@@ -0,0 +1,273 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"sync"
+)
+
+// UnknownValidatorError is returned by ValidatorRegistry.New for a name that is not registered.
+type UnknownValidatorError struct {
+	Name string
+}
+
+// DuplicateValidatorError is returned by ValidatorRegistry.Register for a name that is already registered.
+type DuplicateValidatorError struct {
+	Name string
+}
+
+// ValidatorArgumentError is returned by ValidatorRegistry.New when a factory rejects its arguments.
+type ValidatorArgumentError struct {
+	Name   string
+	Reason string
+}
+
+// Error returns the error message.
+func (e UnknownValidatorError) Error() string {
+	return fmt.Sprintf("unknown validator %q", e.Name)
+}
+
+// Error returns the error message.
+func (e DuplicateValidatorError) Error() string {
+	return fmt.Sprintf("validator %q is already registered", e.Name)
+}
+
+// Error returns the error message.
+func (e ValidatorArgumentError) Error() string {
+	return fmt.Sprintf("invalid arguments for validator %q: %s", e.Name, e.Reason)
+}
+
+// ValidatorFactory creates a validator from its arguments, returning an error
+// describing the problem if they are missing or malformed. Arguments are
+// decoded JSON or YAML values: strings, float64 numbers, booleans, lists and maps.
+type ValidatorFactory func(args []any) (ContextValidator, error)
+
+// ValidatorRegistry maps validator names to factories, so that schema files,
+// struct tags and JSON Schema documents can refer to validators by name.
+// It is safe for concurrent use.
+type ValidatorRegistry struct {
+	mu        sync.RWMutex
+	factories map[string]ValidatorFactory
+}
+
+// DefaultValidatorRegistry is used by struct tags, JSON Schema import and
+// schema files loaded without a registry. Register validators here to make
+// them available everywhere.
+var DefaultValidatorRegistry = NewValidatorRegistry()
+
+// NewValidatorRegistry creates a registry with the built-in validators under
+// the names used by DescribeValidator, such as "min_length" and "one_of".
+//
+// Example:
+//
+//	registry := NewValidatorRegistry()
+//	err := registry.Register("no_profanity", func(args []any) (ContextValidator, error) {
+//	    return noProfanity, nil
+//	})
+func NewValidatorRegistry() *ValidatorRegistry {
+	r := &ValidatorRegistry{factories: make(map[string]ValidatorFactory, len(builtinFactories))}
+	for name, factory := range builtinFactories {
+		r.factories[name] = factory
+	}
+	return r
+}
+
+// Register adds a named validator factory.
+// Returns a DuplicateValidatorError if the name is already registered, including built-in names.
+func (r *ValidatorRegistry) Register(name string, factory ValidatorFactory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.factories[name]; exists {
+		return DuplicateValidatorError{Name: name}
+	}
+	r.factories[name] = factory
+	return nil
+}
+
+// Unregister removes a named validator factory, if it is registered.
+// Schemas already built keep the validators created from it.
+func (r *ValidatorRegistry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.factories, name)
+}
+
+// New creates the validator registered under name with the given arguments.
+// Returns an UnknownValidatorError for names that are not registered and a
+// ValidatorArgumentError if the factory rejects the arguments.
+//
+// Example:
+//
+//	validator, err := DefaultValidatorRegistry.New("max_length", 20)
+func (r *ValidatorRegistry) New(name string, args ...any) (ContextValidator, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, UnknownValidatorError{Name: name}
+	}
+
+	validator, err := factory(args)
+	if err != nil {
+		return nil, ValidatorArgumentError{Name: name, Reason: err.Error()}
+	}
+	return validator, nil
+}
+
+// Names returns the registered names in sorted order.
+func (r *ValidatorRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Argument errors of the built-in validator factories.
+var (
+	errWantNonNegativeInteger = errors.New("expected a non-negative integer")
+	errWantNumber             = errors.New("expected a number")
+	errWantString             = errors.New("expected a string")
+	errWantBoolean            = errors.New("expected a boolean")
+)
+
+// builtinFactories creates the built-in validators by name.
+var builtinFactories = map[string]ValidatorFactory{
+	"is_string":    plainFactory(IsStringValidator),
+	"is_integer":   plainFactory(IsIntegerValidator),
+	"number":       plainFactory(NumberValidator),
+	"is_boolean":   plainFactory(IsBooleanValidator),
+	"is_list":      plainFactory(IsListValidator),
+	"is_map":       plainFactory(IsMapValidator),
+	"nullable":     plainFactory(NullableValidator),
+	"non_nullable": plainFactory(NonNullableValidator),
+	"email":        plainFactory(EmailValidator),
+	"uuid":         plainFactory(UUIDValidator),
+	"url":          plainFactory(URLValidator),
+	"ipv4":         plainFactory(IPv4Validator),
+	"ipv6":         plainFactory(IPv6Validator),
+	"xid":          plainFactory(XIDValidator),
+	"base64":       plainFactory(Base64Validator),
+	"json":         plainFactory(JSONValidator),
+	"luhn":         plainFactory(LuhnValidator),
+	"lower_case":   plainFactory(LowerCaseValidator),
+	"upper_case":   plainFactory(UpperCaseValidator),
+	"float":        intFactory(FloatValidator),
+	"min_length":   intFactory(MinLengthValidator),
+	"max_length":   intFactory(MaxLengthValidator),
+	"min_size":     sizeFactory(MinSizeValidator),
+	"max_size":     sizeFactory(MaxSizeValidator),
+	"min_float":    floatFactory(MinFloatValidator),
+	"max_float":    floatFactory(MaxFloatValidator),
+	"one_of": func(args []any) (ContextValidator, error) {
+		if len(args) == 0 {
+			return nil, errors.New("expected at least 1 argument")
+		}
+		return OneOfValidator(args...), nil
+	},
+	"regex": func(args []any) (ContextValidator, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expected 1 argument, got %d", len(args))
+		}
+		pattern, ok := args[0].(string)
+		if !ok {
+			return nil, errWantString
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		return RegexpValidator(*re), nil
+	},
+}
+
+// plainFactory adapts a validator without arguments to a ValidatorFactory.
+func plainFactory(validator ContextValidator) ValidatorFactory {
+	return func(args []any) (ContextValidator, error) {
+		if len(args) != 0 {
+			return nil, fmt.Errorf("expected no arguments, got %d", len(args))
+		}
+		return validator, nil
+	}
+}
+
+// intFactory adapts a validator constructor taking an int to a ValidatorFactory.
+func intFactory(constructor func(int) ContextValidator) ValidatorFactory {
+	return func(args []any) (ContextValidator, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expected 1 argument, got %d", len(args))
+		}
+		n, ok := intArgument(args[0])
+		if !ok {
+			return nil, errWantNonNegativeInteger
+		}
+		return constructor(n), nil
+	}
+}
+
+// floatFactory adapts a validator constructor taking a float64 to a ValidatorFactory.
+func floatFactory(constructor func(float64) ContextValidator) ValidatorFactory {
+	return func(args []any) (ContextValidator, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expected 1 argument, got %d", len(args))
+		}
+		f, ok := floatArgument(args[0])
+		if !ok {
+			return nil, errWantNumber
+		}
+		return constructor(f), nil
+	}
+}
+
+// sizeFactory adapts MinSizeValidator and MaxSizeValidator, whose blocks argument defaults to false.
+func sizeFactory(constructor func(int, bool) ContextValidator) ValidatorFactory {
+	return func(args []any) (ContextValidator, error) {
+		if len(args) != 1 && len(args) != 2 {
+			return nil, fmt.Errorf("expected 1 or 2 arguments, got %d", len(args))
+		}
+		n, ok := intArgument(args[0])
+		if !ok {
+			return nil, errWantNonNegativeInteger
+		}
+		blocks := false
+		if len(args) == 2 {
+			if blocks, ok = args[1].(bool); !ok {
+				return nil, errWantBoolean
+			}
+		}
+		return constructor(n, blocks), nil
+	}
+}
+
+// intArgument converts a decoded non-negative whole number to an int.
+func intArgument(value any) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, v >= 0
+	case float64:
+		if v >= 0 && v <= math.MaxInt32 && v == math.Trunc(v) {
+			return int(v), true
+		}
+	}
+	return 0, false
+}
+
+// floatArgument converts a decoded number to a float64.
+func floatArgument(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,166 @@
+package govalidator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gstachniukrsk/govalidator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorRegistry_New(t *testing.T) {
+	registry := govalidator.NewValidatorRegistry()
+
+	t.Run("built-in validators", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			args       []any
+			wantParams []govalidator.ValidatorParam
+		}{
+			{name: "is_string"},
+			{name: "is_integer"},
+			{name: "number"},
+			{name: "is_boolean"},
+			{name: "is_list"},
+			{name: "is_map"},
+			{name: "nullable"},
+			{name: "non_nullable"},
+			{name: "email"},
+			{name: "uuid"},
+			{name: "url"},
+			{name: "ipv4"},
+			{name: "ipv6"},
+			{name: "xid"},
+			{name: "base64"},
+			{name: "json"},
+			{name: "luhn"},
+			{name: "lower_case"},
+			{name: "upper_case"},
+			{name: "float", args: []any{2.0}, wantParams: []govalidator.ValidatorParam{{Name: "max_precision", Value: 2}}},
+			{name: "min_length", args: []any{3}, wantParams: []govalidator.ValidatorParam{{Name: "min", Value: 3}}},
+			{name: "max_length", args: []any{3.0}, wantParams: []govalidator.ValidatorParam{{Name: "max", Value: 3}}},
+			{name: "min_size", args: []any{1.0}, wantParams: []govalidator.ValidatorParam{{Name: "min", Value: 1}, {Name: "blocks", Value: false}}},
+			{name: "max_size", args: []any{5.0, true}, wantParams: []govalidator.ValidatorParam{{Name: "max", Value: 5}, {Name: "blocks", Value: true}}},
+			{name: "min_float", args: []any{0.5}, wantParams: []govalidator.ValidatorParam{{Name: "min", Value: 0.5}}},
+			{name: "max_float", args: []any{10}, wantParams: []govalidator.ValidatorParam{{Name: "max", Value: 10.0}}},
+			{name: "one_of", args: []any{"a", 1.0}, wantParams: []govalidator.ValidatorParam{{Name: "options", Value: []any{"a", 1.0}}}},
+			{name: "regex", args: []any{"^a$"}, wantParams: []govalidator.ValidatorParam{{Name: "pattern", Value: "^a$"}}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				validator, err := registry.New(tt.name, tt.args...)
+				require.NoError(t, err)
+
+				descriptor, ok := govalidator.DescribeValidator(validator)
+				require.True(t, ok)
+				assert.Equal(t, tt.name, descriptor.Name())
+				if tt.wantParams == nil {
+					assert.Empty(t, descriptor.Params())
+				} else {
+					assert.Equal(t, tt.wantParams, descriptor.Params())
+				}
+			})
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		tests := []struct {
+			name string
+			args []any
+			want string
+		}{
+			{name: "email", args: []any{true}, want: "expected no arguments, got 1"},
+			{name: "min_length", want: "expected 1 argument, got 0"},
+			{name: "min_length", args: []any{-1.0}, want: "expected a non-negative integer"},
+			{name: "max_length", args: []any{1.5}, want: "expected a non-negative integer"},
+			{name: "float", args: []any{"2"}, want: "expected a non-negative integer"},
+			{name: "min_size", args: []any{1.0, 2.0, 3.0}, want: "expected 1 or 2 arguments, got 3"},
+			{name: "max_size", args: []any{1.0, "yes"}, want: "expected a boolean"},
+			{name: "min_float", args: []any{"1"}, want: "expected a number"},
+			{name: "one_of", want: "expected at least 1 argument"},
+			{name: "regex", args: []any{1.0}, want: "expected a string"},
+			{name: "regex", args: []any{"("}, want: "error parsing regexp: missing closing ): `(`"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				validator, err := registry.New(tt.name, tt.args...)
+
+				assert.Nil(t, validator)
+				assert.Equal(t, govalidator.ValidatorArgumentError{Name: tt.name, Reason: tt.want}, err)
+			})
+		}
+
+		_, err := registry.New("min_length")
+		assert.EqualError(t, err, `invalid arguments for validator "min_length": expected 1 argument, got 0`)
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		validator, err := registry.New("is_email")
+
+		assert.Nil(t, validator)
+		assert.Equal(t, govalidator.UnknownValidatorError{Name: "is_email"}, err)
+		assert.EqualError(t, err, `unknown validator "is_email"`)
+	})
+}
+
+func TestValidatorRegistry_Register(t *testing.T) {
+	errOdd := errors.New("odd")
+	even := func(args []any) (govalidator.ContextValidator, error) {
+		return func(_ context.Context, value any) (bool, []error) {
+			if n, ok := value.(float64); ok && int(n)%2 != 0 {
+				return false, []error{errOdd}
+			}
+			return false, nil
+		}, nil
+	}
+
+	t.Run("custom validators", func(t *testing.T) {
+		registry := govalidator.NewValidatorRegistry()
+		require.NoError(t, registry.Register("even", even))
+
+		validator, err := registry.New("even")
+		require.NoError(t, err)
+		_, errs := validator(context.Background(), 3.0)
+		assert.Equal(t, []error{errOdd}, errs)
+
+		assert.Contains(t, registry.Names(), "even")
+		_, err = govalidator.NewValidatorRegistry().New("even")
+		assert.Equal(t, govalidator.UnknownValidatorError{Name: "even"}, err)
+	})
+
+	t.Run("duplicate names", func(t *testing.T) {
+		registry := govalidator.NewValidatorRegistry()
+		require.NoError(t, registry.Register("even", even))
+
+		err := registry.Register("even", even)
+		assert.Equal(t, govalidator.DuplicateValidatorError{Name: "even"}, err)
+		assert.EqualError(t, err, `validator "even" is already registered`)
+
+		err = registry.Register("email", even)
+		assert.Equal(t, govalidator.DuplicateValidatorError{Name: "email"}, err)
+	})
+
+	t.Run("unregistered names", func(t *testing.T) {
+		registry := govalidator.NewValidatorRegistry()
+		require.NoError(t, registry.Register("even", even))
+
+		registry.Unregister("even")
+		registry.Unregister("never_registered")
+
+		_, err := registry.New("even")
+		assert.Equal(t, govalidator.UnknownValidatorError{Name: "even"}, err)
+		assert.NoError(t, registry.Register("even", even))
+	})
+}
+
+func TestValidatorRegistry_Names(t *testing.T) {
+	assert.Equal(t, []string{
+		"base64", "email", "float", "ipv4", "ipv6", "is_boolean", "is_integer", "is_list", "is_map", "is_string",
+		"json", "lower_case", "luhn", "max_float", "max_length", "max_size", "min_float", "min_length", "min_size",
+		"non_nullable", "nullable", "number", "one_of", "regex", "upper_case", "url", "uuid", "xid",
+	}, govalidator.NewValidatorRegistry().Names())
+}